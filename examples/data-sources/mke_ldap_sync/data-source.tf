@@ -0,0 +1,9 @@
+# Read the most recent LDAP sync job
+data "mke_ldap_sync" "latest" {
+}
+
+# OPTIONAL: Output the errors of the most recent sync
+output "ldap_sync_errors" {
+  description = "errors logged by the most recent LDAP sync"
+  value       = data.mke_ldap_sync.latest.errors
+}
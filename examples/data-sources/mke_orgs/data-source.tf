@@ -0,0 +1,9 @@
+# List every organization
+data "mke_orgs" "all" {
+}
+
+# OPTIONAL: Output the organization names
+output "org_names" {
+  description = "names of all of the organizations"
+  value       = data.mke_orgs.all.orgs[*].name
+}
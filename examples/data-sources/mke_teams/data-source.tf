@@ -0,0 +1,10 @@
+# List the teams of an organization
+data "mke_teams" "engineering" {
+  org = "engineering"
+}
+
+# OPTIONAL: Output the team names
+output "engineering_teams" {
+  description = "names of the teams in the engineering organization"
+  value       = data.mke_teams.engineering.teams[*].name
+}
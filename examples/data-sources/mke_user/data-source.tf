@@ -0,0 +1,10 @@
+# Look up an existing user by name, e.g. to use its ID
+data "mke_user" "ci" {
+  name = "ci-bot"
+}
+
+# OPTIONAL: Output whether the user has two-factor authentication
+output "ci_otp_enabled" {
+  description = "does the CI user have two-factor authentication enabled"
+  value       = data.mke_user.ci.otp_enabled
+}
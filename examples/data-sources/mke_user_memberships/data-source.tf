@@ -0,0 +1,14 @@
+# The organizations and teams of a CI user
+data "mke_user_memberships" "ci" {
+  user = "ci-bot"
+}
+
+# OPTIONAL: Fail the run if the user is in any team other than the expected ones
+check "ci_teams" {
+  assert {
+    condition = alltrue([
+      for t in data.mke_user_memberships.ci.teams : contains(["engineering/ci"], "${t.org}/${t.name}")
+    ])
+    error_message = "ci-bot is in unexpected teams"
+  }
+}
@@ -0,0 +1,18 @@
+# List the admins, for an audit
+data "mke_users" "admins" {
+  filter = "admins"
+}
+
+# Admins without two-factor authentication
+data "mke_users" "admins_without_otp" {
+  filter      = "admins"
+  otp_enabled = false
+}
+
+# OPTIONAL: Fail the run if any admin has no two-factor authentication
+check "admins_use_otp" {
+  assert {
+    condition     = length(data.mke_users.admins_without_otp.users) == 0
+    error_message = "Admins without OTP: ${join(", ", data.mke_users.admins_without_otp.users[*].name)}"
+  }
+}
@@ -0,0 +1,2 @@
+# Public keys are imported using the account name and the key ID, separated by a slash
+terraform import mke_account_public_key.hsm payments/3f2a9c0e5b7d4e1f8a6c2b9d0e7f4a1c5b8d2e6f9a3c7b0d4e8f1a5c9b2d6e0f
@@ -0,0 +1,15 @@
+# A service identity whose key was generated in an HSM, so MKE only needs to trust it
+resource "mke_user" "service" {
+  name = "payments"
+}
+
+resource "mke_account_public_key" "hsm" {
+  account    = mke_user.service.name
+  public_key = file("${path.module}/payments.pub.pem")
+  label      = "payments HSM key"
+
+  certificate {
+    label = "payments"
+    cert  = file("${path.module}/payments.crt.pem")
+  }
+}
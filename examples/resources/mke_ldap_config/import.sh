@@ -0,0 +1,2 @@
+# There is only one LDAP config per cluster, which is imported using the ID ldap
+terraform import mke_ldap_config.example ldap
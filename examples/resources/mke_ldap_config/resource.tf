@@ -0,0 +1,22 @@
+# Configure MKE to authenticate users against an LDAP directory
+resource "mke_ldap_config" "example" {
+  server_url = "ldaps://ldap.example.com"
+  bind_dn    = "cn=reader,dc=example,dc=com"
+
+  # the password is write-only, so bump the version to send a new one
+  bind_password_wo         = var.ldap_bind_password
+  bind_password_wo_version = 1
+
+  user_search {
+    base_dn        = "ou=people,dc=example,dc=com"
+    username_attr  = "uid"
+    full_name_attr = "cn"
+    scope_subtree  = true
+  }
+
+  # OPTIONAL: try an LDAP login with the planned settings, failing the plan if it does not work
+  test_login {
+    username    = "jdoe"
+    password_wo = var.ldap_test_password
+  }
+}
@@ -0,0 +1,9 @@
+# Sync users and team members from LDAP whenever the LDAP settings or team sync change
+resource "mke_ldap_sync_trigger" "example" {
+  triggers = {
+    server_url = mke_ldap_config.example.server_url
+    team_sync  = mke_team_ldap_sync.example.group_dn
+  }
+
+  wait_timeout = "15m"
+}
@@ -0,0 +1,2 @@
+# Orgs are imported using the org name
+terraform import mke_org.example engineering
@@ -0,0 +1,5 @@
+# Create an MKE organization
+resource "mke_org" "example" {
+  name      = "engineering"
+  full_name = "Engineering"
+}
@@ -0,0 +1,2 @@
+# Org members are imported using the org and user names, separated by a slash
+terraform import mke_org_member.example engineering/alice
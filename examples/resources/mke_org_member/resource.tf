@@ -0,0 +1,6 @@
+# Make a user an admin of an MKE organization
+resource "mke_org_member" "example" {
+  org      = "engineering"
+  user     = "alice"
+  is_admin = true
+}
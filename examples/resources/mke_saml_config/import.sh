@@ -0,0 +1,2 @@
+# There is only one SAML config per cluster, which is imported using the ID saml
+terraform import mke_saml_config.example saml
@@ -0,0 +1,26 @@
+# Configure MKE for SAML single sign-on
+resource "mke_saml_config" "example" {
+  idp_metadata_url = "https://example.okta.com/app/exk1234/sso/saml/metadata"
+  sp_host          = "https://mke.example.com"
+
+  # Users with "platform" in their SAML groups attribute join the platform team
+  team_mapping {
+    attribute_name  = "groups"
+    attribute_value = "platform"
+    org             = "engineering"
+    team            = "platform"
+  }
+
+  # Users with "engineers" in their SAML groups attribute only join the org
+  team_mapping {
+    attribute_name  = "groups"
+    attribute_value = "engineers"
+    org             = "engineering"
+  }
+}
+
+# OPTIONAL: Output the service provider metadata, for registering MKE with the IdP
+output "saml_sp_metadata" {
+  description = "the SAML service provider metadata XML of MKE"
+  value       = mke_saml_config.example.sp_metadata
+}
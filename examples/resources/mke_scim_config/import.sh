@@ -0,0 +1,2 @@
+# There is only one SCIM config per cluster, which is imported using the ID scim
+terraform import mke_scim_config.example scim
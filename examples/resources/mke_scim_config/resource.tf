@@ -0,0 +1,4 @@
+# Accept SCIM user provisioning from an IdP
+resource "mke_scim_config" "example" {
+  enabled = true
+}
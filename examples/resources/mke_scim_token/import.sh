@@ -0,0 +1,2 @@
+# SCIM tokens are imported using their ID. The token value can't be imported.
+terraform import mke_scim_token.example 2f1c9f3e-6a53-4c2b-9d2e-8b7f6a1d0c4e
@@ -0,0 +1,13 @@
+# Generate a SCIM bearer token for the IdP to provision users with
+resource "mke_scim_token" "example" {
+  description = "okta provisioning"
+
+  depends_on = [mke_scim_config.example]
+}
+
+# OPTIONAL: Output the token, to configure in the IdP
+output "scim_token" {
+  sensitive   = true
+  description = "the SCIM bearer token"
+  value       = mke_scim_token.example.token
+}
@@ -0,0 +1,2 @@
+# Teams are imported using the org and team names, separated by a slash
+terraform import mke_team.example engineering/platform
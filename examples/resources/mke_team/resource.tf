@@ -0,0 +1,10 @@
+# Create an MKE team inside an organization
+resource "mke_org" "example" {
+  name = "engineering"
+}
+
+resource "mke_team" "example" {
+  org         = mke_org.example.name
+  name        = "platform"
+  description = "Platform engineering"
+}
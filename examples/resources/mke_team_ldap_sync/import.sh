@@ -0,0 +1,2 @@
+# Team LDAP sync is imported using the org and team names, separated by a slash
+terraform import mke_team_ldap_sync.example engineering/platform
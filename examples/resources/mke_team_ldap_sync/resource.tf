@@ -0,0 +1,9 @@
+# Sync the members of an MKE team from the members of an LDAP group
+resource "mke_team_ldap_sync" "example" {
+  org  = "engineering"
+  team = "platform"
+
+  select_group_members = true
+  group_dn             = "cn=platform,ou=groups,dc=example,dc=com"
+  group_member_attr    = "member"
+}
@@ -0,0 +1,2 @@
+# Team members are imported using the org, team and user names, separated by slashes
+terraform import mke_team_member.example engineering/platform/alice
@@ -0,0 +1,7 @@
+# Add a single user to an MKE team, leaving other members alone
+resource "mke_team_member" "example" {
+  org      = "engineering"
+  team     = "platform"
+  user     = "alice"
+  is_admin = true
+}
@@ -0,0 +1,2 @@
+# Team members are imported using the org and team names, separated by a slash
+terraform import mke_team_members.example engineering/platform
@@ -0,0 +1,11 @@
+# Manage the complete member list of an MKE team.
+# Members which are not listed here are removed from the team.
+resource "mke_team_members" "example" {
+  org  = "engineering"
+  team = "platform"
+
+  members = [
+    { user = "alice", is_admin = true },
+    { user = "bob" },
+  ]
+}
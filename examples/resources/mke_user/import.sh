@@ -0,0 +1,3 @@
+# Users are imported using the user name or ID.
+# Passwords are not imported, and an imported user keeps its password until the keepers or password_policy change after the import.
+terraform import mke_user.managed jdoe
@@ -0,0 +1,43 @@
+# A user with a password managed by MKE
+resource "mke_user" "managed" {
+  name      = "jdoe"
+  password  = var.jdoe_password
+  full_name = "Jane Doe"
+
+  # Keep the account, with its grants and audit history, when the user leaves
+  deletion_policy = "deactivate"
+}
+
+# A user with a write-only password, which is never stored in state (Terraform 1.11 or later).
+# Bump the version to send a new password.
+resource "mke_user" "deploy" {
+  name                = "deploy"
+  password_wo         = var.deploy_password
+  password_wo_version = 1
+}
+
+# A user looked up in LDAP, who logs in with their LDAP password
+resource "mke_user" "ldap" {
+  name        = "oncall"
+  full_name   = "On-call rotation"
+  auth_source = "ldap"
+}
+
+# A user with a generated password, which is rotated when the keepers change
+resource "mke_user" "ci" {
+  name = "ci"
+
+  keepers = {
+    rotation = "2024-q1"
+  }
+
+  password_policy {
+    length  = 24
+    exclude = "0Ol1I"
+  }
+}
+
+output "ci_password" {
+  value     = mke_user.ci.generated_password
+  sensitive = true
+}
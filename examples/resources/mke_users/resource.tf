@@ -0,0 +1,15 @@
+# Users from an HR export, e.g. a CSV with name, full_name and admin columns
+locals {
+  people = csvdecode(file("${path.module}/people.csv"))
+}
+
+resource "mke_users" "staff" {
+  parallelism = 20
+
+  users = {
+    for p in local.people : p.name => {
+      full_name = p.full_name
+      is_admin  = p.admin == "yes"
+    }
+  }
+}
@@ -19,10 +19,18 @@ type CreateAccount struct {
 }
 
 // UpdateAccount struct.
+// Nil fields are left unchanged, so that flags can also be set back to false.
 type UpdateAccount struct {
-	FullName string `json:"fullName,omitempty"`
-	IsActive bool   `json:"isActive,omitempty"`
-	IsAdmin  bool   `json:"isAdmin,omitempty"`
+	FullName *string `json:"fullName,omitempty"`
+	IsActive *bool   `json:"isActive,omitempty"`
+	IsAdmin  *bool   `json:"isAdmin,omitempty"`
+}
+
+// ChangePassword struct.
+// The old password is needed when users change their own password, but not when an admin resets it.
+type ChangePassword struct {
+	OldPassword string `json:"oldPassword,omitempty"`
+	NewPassword string `json:"newPassword"`
 }
 
 // ResponseAccount struct.
@@ -53,18 +61,24 @@ type ResponseAccounts struct {
 type AccountFilter string
 
 const (
-	AccountFilterUsers         AccountFilter = "user"
+	AccountFilterAll           AccountFilter = "all"
+	AccountFilterUsers         AccountFilter = "users"
 	AccountFilterOrgs          AccountFilter = "orgs"
 	AccountFilterAdmins        AccountFilter = "admins"
 	AccountFilterNonAdmins     AccountFilter = "non-admins"
 	AccountFilterActiveUsers   AccountFilter = "active-users"
 	AccountFilterInactiveUsers AccountFilter = "inactive-users"
 	URLTargetForAccounts                     = "accounts"
+
+	// /accounts/{accountNameOrID}/changePassword url.
+	URLTargetPatternForAccountPassword = "accounts/%s/changePassword"
+	// /accounts/{accountNameOrID}/totp url.
+	URLTargetPatternForAccountTOTP = "accounts/%s/totp"
 )
 
 // APIFormOfFilter is a string readable form of the AccountFilters enum.
 func (accF AccountFilter) APIFormOfFilter() string {
-	filters := [...]string{"users", "orgs", "admins", "non-admins", "active-users"}
+	filters := [...]string{"users", "orgs", "admins", "non-admins", "active-users", "inactive-users"}
 
 	x := string(accF)
 	for _, v := range filters {
@@ -157,30 +171,68 @@ func (c *Client) ApiUpdateAccount(ctx context.Context, id string, acc UpdateAcco
 	return resAcc, nil
 }
 
-// ReadAccounts method retrieves all accounts depending on the filter passed from the enzi endpoint.
-func (c *Client) ApiReadAccounts(ctx context.Context, accFilter AccountFilter) ([]ResponseAccount, error) {
-	// req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.createEnziUrl("accounts"), nil)
-	req, err := c.RequestFromTargetAndBytesBody(ctx, http.MethodGet, URLTargetForAccounts, []byte{})
-	if err != nil {
-		return []ResponseAccount{}, fmt.Errorf("reading accounts in bulk '%s' failed. %w: %s",
-			accFilter.APIFormOfFilter(), ErrRequestCreation, err)
+// ApiChangePassword change the password of the account that the client is logged in as, which needs the old password.
+func (c *Client) ApiChangePassword(ctx context.Context, id, oldPassword, newPassword string) (ResponseAccount, error) {
+	if oldPassword == "" || newPassword == "" {
+		return ResponseAccount{}, fmt.Errorf("changing password of account %s failed. %w: the old and new passwords are required", id, ErrEmptyUsernamePass)
 	}
+	return c.apiChangePassword(ctx, id, ChangePassword{OldPassword: oldPassword, NewPassword: newPassword})
+}
 
-	q := req.URL.Query()
-	q.Add("filter", accFilter.APIFormOfFilter())
-	req.URL.RawQuery = q.Encode()
+// ApiResetPassword set the password of another account, without the old password. Only admins can reset passwords.
+func (c *Client) ApiResetPassword(ctx context.Context, id, newPassword string) (ResponseAccount, error) {
+	if newPassword == "" {
+		return ResponseAccount{}, fmt.Errorf("resetting password of account %s failed. %w: the new password is required", id, ErrEmptyUsernamePass)
+	}
+	return c.apiChangePassword(ctx, id, ChangePassword{NewPassword: newPassword})
+}
+
+func (c *Client) apiChangePassword(ctx context.Context, id string, cp ChangePassword) (ResponseAccount, error) {
+	url := fmt.Sprintf(URLTargetPatternForAccountPassword, id)
+
+	req, err := c.RequestFromTargetAndJSONBody(ctx, http.MethodPost, url, cp)
+	if err != nil {
+		return ResponseAccount{}, fmt.Errorf("changing password of account %s failed. %w: %s", id, ErrRequestCreation, err)
+	}
 
 	resp, err := c.doAuthorizedRequest(req)
 	if err != nil {
-		return []ResponseAccount{}, fmt.Errorf("reading accounts in bulk '%s' failed. %w",
-			accFilter.APIFormOfFilter(), err)
+		return ResponseAccount{}, fmt.Errorf("changing password of account %s failed. %w", id, err)
+	}
+
+	resAcc := ResponseAccount{}
+	if err := resp.JSONMarshallBody(&resAcc); err != nil {
+		return ResponseAccount{}, fmt.Errorf("changing password of account %s failed. %w: %s", id, ErrUnmarshaling, err)
+	}
+	return resAcc, nil
+}
+
+// ApiResetAccountOTP disable the TOTP two-factor authentication of an account, e.g. when the user lost their device.
+// The user can then log in with only their password, and set up a new device.
+func (c *Client) ApiResetAccountOTP(ctx context.Context, id string) error {
+	url := fmt.Sprintf(URLTargetPatternForAccountTOTP, id)
+
+	req, err := c.RequestFromTargetAndBytesBody(ctx, http.MethodDelete, url, []byte{})
+	if err != nil {
+		return fmt.Errorf("resetting OTP of account %s failed. %w: %s", id, ErrRequestCreation, err)
 	}
 
-	var accs ResponseAccounts
-	if err := resp.JSONMarshallBody(&accs); err != nil {
-		return []ResponseAccount{}, fmt.Errorf("reading accounts in bulk '%s' failed. %w: %s",
-			accFilter.APIFormOfFilter(), ErrUnmarshaling, err)
+	if _, err := c.doAuthorizedRequest(req); err != nil {
+		return fmt.Errorf("resetting OTP of account %s failed. %w", id, err)
+	}
+	return nil
+}
+
+// ReadAccounts method retrieves all accounts depending on the filter passed from the enzi endpoint, following pagination.
+func (c *Client) ApiReadAccounts(ctx context.Context, accFilter AccountFilter) ([]ResponseAccount, error) {
+	query := map[string][]string{"filter": {accFilter.APIFormOfFilter()}}
+
+	accs, err := listAllPages(ctx, c, URLTargetForAccounts, query, func(p ResponseAccounts) ([]ResponseAccount, string) {
+		return p.Accounts, p.NextPageStart
+	})
+	if err != nil {
+		return accs, fmt.Errorf("reading accounts in bulk '%s' failed. %w", accFilter.APIFormOfFilter(), err)
 	}
 
-	return accs.Accounts, nil
+	return accs, nil
 }
@@ -2,10 +2,12 @@ package client_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/Mirantis/terraform-provider-mke/internal/client"
@@ -141,13 +143,38 @@ func TestUpdateAccountSuccess(t *testing.T) {
 
 	c, _ := s.Client()
 
-	if resp, err := c.ApiUpdateAccount(ctx, acc, client.UpdateAccount{FullName: "mock"}); err != nil {
+	if resp, err := c.ApiUpdateAccount(ctx, acc, client.UpdateAccount{FullName: client.Ptr("mock")}); err != nil {
 		t.Errorf("unexpected error updating account: %s", err.Error())
 	} else if !reflect.DeepEqual(expectedAcc, resp) {
 		t.Errorf("expected resp: (%+v),\n got (%+v)", expectedAcc, resp)
 	}
 }
 
+func TestUpdateAccountSendsFalseFlags(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodPatch, fmt.Sprintf("%s/%s", client.URLTargetForAccounts, "testuser"), func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("update account body was not json: %s", err)
+		}
+		expected := map[string]any{"isActive": false, "isAdmin": false}
+		if !reflect.DeepEqual(expected, body) {
+			t.Errorf("expected body (%v), got (%v)", expected, body)
+		}
+		MockServerHandlerGeneratorReturnJson(client.ResponseAccount{Name: "testuser"})(w, r)
+	})
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	if _, err := c.ApiUpdateAccount(ctx, "testuser", client.UpdateAccount{IsActive: client.Ptr(false), IsAdmin: client.Ptr(false)}); err != nil {
+		t.Errorf("unexpected error updating account: %s", err.Error())
+	}
+}
+
 func TestReadAccountsSuccess(t *testing.T) {
 	ctx := context.Background()
 	auth := commonTestAuth
@@ -178,3 +205,202 @@ func TestReadAccountsSuccess(t *testing.T) {
 		t.Errorf("expected resp: (%+v),\n got (%+v)", expectedAccs, resp)
 	}
 }
+
+func TestReadAccountsFollowsPages(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	pages := map[string]client.ResponseAccounts{
+		"":      {NextPageStart: "mock2", Accounts: []client.ResponseAccount{{Name: "mock1"}}},
+		"mock2": {NextPageStart: "mock3", Accounts: []client.ResponseAccount{{Name: "mock2"}}},
+		"mock3": {Accounts: []client.ResponseAccount{{Name: "mock3"}}},
+	}
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodGet, client.URLTargetForAccounts, func(w http.ResponseWriter, r *http.Request) {
+		if filter := r.URL.Query()["filter"]; len(filter) != 1 || filter[0] != "all" {
+			t.Errorf("expected the filter to be kept on every page, got %q", filter)
+		}
+		if authHeaders := r.Header.Values(client.HeaderKeyAuthorization); len(authHeaders) != 1 {
+			t.Errorf("expected one authorization header on every page, got %d", len(authHeaders))
+		}
+		MockServerHandlerGeneratorReturnJson(pages[r.URL.Query().Get(client.URLQueryPageStart)])(w, r)
+	})
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	accs, err := c.ApiReadAccounts(ctx, client.AccountFilterAll)
+	if err != nil {
+		t.Fatalf("unexpected error reading accounts: %s", err.Error())
+	}
+	expectedAccs := []client.ResponseAccount{{Name: "mock1"}, {Name: "mock2"}, {Name: "mock3"}}
+	if !reflect.DeepEqual(expectedAccs, accs) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", expectedAccs, accs)
+	}
+}
+
+func TestReadAccountsStopsOnRepeatedPage(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	requests := 0
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodGet, client.URLTargetForAccounts, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		// a server which always points at the same next page
+		MockServerHandlerGeneratorReturnJson(client.ResponseAccounts{NextPageStart: "mock2", Accounts: []client.ResponseAccount{{Name: "mock2"}}})(w, r)
+	})
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	if _, err := c.ApiReadAccounts(ctx, client.AccountFilterAll); !errors.Is(err, client.ErrRepeatedPage) {
+		t.Errorf("expected a repeated page error, got: %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("expected the listing to stop after 2 requests, got %d", requests)
+	}
+}
+
+func TestAccountFilterAPIForm(t *testing.T) {
+	filters := map[client.AccountFilter]string{
+		client.AccountFilterAll:           "all",
+		client.AccountFilterUsers:         "users",
+		client.AccountFilterOrgs:          "orgs",
+		client.AccountFilterAdmins:        "admins",
+		client.AccountFilterNonAdmins:     "non-admins",
+		client.AccountFilterActiveUsers:   "active-users",
+		client.AccountFilterInactiveUsers: "inactive-users",
+		client.AccountFilter("unknown"):   "all",
+	}
+
+	for filter, expected := range filters {
+		if got := filter.APIFormOfFilter(); got != expected {
+			t.Errorf("expected filter %q to be sent as %q, got %q", filter, expected, got)
+		}
+	}
+}
+
+func TestReadAccountNotFound(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	acc := "testuser"
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodGet, fmt.Sprintf("%s/%s", client.URLTargetForAccounts, acc), MockServerHandlerGeneratorReturnResponseStatus(http.StatusNotFound))
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	if _, err := c.ApiReadAccount(ctx, acc); !client.IsNotFound(err) {
+		t.Errorf("expected a not found error, got: %v", err)
+	}
+}
+
+func TestReadAccountServerErrorIsNotNotFound(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	acc := "testuser"
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodGet, fmt.Sprintf("%s/%s", client.URLTargetForAccounts, acc), MockServerHandlerGeneratorReturnResponseStatus(http.StatusInternalServerError))
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	if _, err := c.ApiReadAccount(ctx, acc); err == nil {
+		t.Error("expected a server error")
+	} else if client.IsNotFound(err) {
+		t.Errorf("server error should not be reported as not found: %s", err)
+	}
+}
+
+func TestResetAccountOTP(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodDelete, fmt.Sprintf(client.URLTargetPatternForAccountTOTP, "testuser"), MockServerHandlerGeneratorReturnResponseStatus(http.StatusNoContent))
+	s.AddHandler(http.MethodDelete, fmt.Sprintf(client.URLTargetPatternForAccountTOTP, "otheruser"), MockServerHandlerGeneratorReturnResponseStatus(http.StatusForbidden))
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	if err := c.ApiResetAccountOTP(ctx, "testuser"); err != nil {
+		t.Errorf("unexpected error resetting OTP: %s", err)
+	}
+	if err := c.ApiResetAccountOTP(ctx, "otheruser"); !client.IsForbidden(err) {
+		t.Errorf("expected a forbidden error, got: %v", err)
+	}
+}
+
+func TestChangePasswordSendsOldPassword(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	accResp := client.ResponseAccount{Name: "testuser", ID: "test-id"}
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodPost, fmt.Sprintf(client.URLTargetPatternForAccountPassword, "testuser"), func(w http.ResponseWriter, r *http.Request) {
+		var cp client.ChangePassword
+		if err := json.NewDecoder(r.Body).Decode(&cp); err != nil {
+			t.Errorf("change password body was not json: %s", err)
+		}
+		if cp.OldPassword != "oldsecret" || cp.NewPassword != "newsecret" {
+			t.Errorf("unexpected change password body: %+v", cp)
+		}
+		MockServerHandlerGeneratorReturnJson(accResp)(w, r)
+	})
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	resp, err := c.ApiChangePassword(ctx, "testuser", "oldsecret", "newsecret")
+	if err != nil {
+		t.Fatalf("change password failed: %s", err)
+	}
+	if resp != accResp {
+		t.Errorf("expected (%v), got (%v)", accResp, resp)
+	}
+
+	if _, err := c.ApiChangePassword(ctx, "testuser", "", "newsecret"); !errors.Is(err, client.ErrEmptyUsernamePass) {
+		t.Errorf("expected a missing old password to be rejected, got: %v", err)
+	}
+}
+
+func TestResetPasswordForbidden(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodPost, fmt.Sprintf(client.URLTargetPatternForAccountPassword, "otheruser"), MockServerHandlerGeneratorReturnResponseStatus(http.StatusForbidden))
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	if _, err := c.ApiResetPassword(ctx, "otheruser", "newsecret"); !client.IsForbidden(err) {
+		t.Errorf("expected a forbidden error, got: %v", err)
+	}
+}
+
+func TestChangePasswordErrorRedactsPasswords(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodPost, fmt.Sprintf(client.URLTargetPatternForAccountPassword, "testuser"), MockServerHandlerGeneratorReturnResponseStatus(http.StatusBadRequest))
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	_, err := c.ApiChangePassword(ctx, "testuser", "oldsecret", "newsecret")
+	if !errors.Is(err, client.ErrResponseError) {
+		t.Fatalf("expected a response error, got: %v", err)
+	}
+	if strings.Contains(err.Error(), "oldsecret") || strings.Contains(err.Error(), "newsecret") {
+		t.Errorf("the passwords were included in the error: %s", err)
+	}
+}
@@ -54,7 +54,7 @@ func (c *Client) ApiClientBundleCreate(ctx context.Context, label string) (Clien
 		return cb, err
 	}
 
-	zr, err := zip.NewReader(bytes.NewReader(zb), resp.ContentLength)
+	zr, err := zip.NewReader(bytes.NewReader(zb), int64(len(zb)))
 	if err != nil {
 		return cb, err
 	}
@@ -125,11 +125,7 @@ func (c *Client) ApiClientBundleCreate(ctx context.Context, label string) (Clien
 				continue
 			}
 
-			dbzr, err := zip.NewReader(bytes.NewReader(dbzb), resp.ContentLength)
-			if err != nil {
-				return cb, err
-			}
-
+			dbzr, err := zip.NewReader(bytes.NewReader(dbzb), int64(len(dbzb)))
 			if err != nil {
 				errs = append(errs, err)
 				continue
@@ -191,7 +187,7 @@ func (c *Client) ApiClientBundleGetPublicKey(ctx context.Context, cb ClientBundl
 		foundKeys = append(foundKeys, pk)
 	}
 
-	return k, fmt.Errorf("%w; Could not match key: \n%s\n in \n%s", ErrFailedToFindClientBundleMKEPublicKey, cb.PublicKey, strings.Join(foundKeys, "\n"))
+	return k, fmt.Errorf("%w; %w; Could not match key: \n%s\n in \n%s", ErrFailedToFindClientBundleMKEPublicKey, ErrUnknownTarget, cb.PublicKey, strings.Join(foundKeys, "\n"))
 }
 
 // ApiClientBundleDelete delete a client bundle by finding and deleting the matching public key.
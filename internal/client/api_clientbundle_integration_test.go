@@ -16,7 +16,7 @@ func TestCreateAndDeleteClientBundle(t *testing.T) {
 		t.Fatalf("Failed generating test client: %s", err)
 	}
 
-	cb, err := c.ApiClientBundleCreate(ctx)
+	cb, err := c.ApiClientBundleCreate(ctx, "integration test")
 	if err != nil {
 		t.Fatalf("Failed generating client bundle: %s", err)
 	}
@@ -44,6 +44,9 @@ a nil Auth should be provided, and an error will occur if authentication is atte
     // call client methods here that rely on the above routes
     // confirm that you receive the expected client responses/errors related to the set URLs
   ```
+
+For tests which need server side state across several calls (create then read, etc.)
+use the stateful fake MKE server in internal/mketest instead.
 */
 
 var (
@@ -0,0 +1,205 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// /jobs url.
+	URLTargetForJobs = "jobs"
+	// /jobs/{jobID} url.
+	URLTargetPatternForJob = "jobs/%s"
+	// /jobs/{jobID}/logs url.
+	URLTargetPatternForJobLogs = "jobs/%s/logs"
+
+	// URLQueryJobAction query key used to list only the jobs for an action.
+	URLQueryJobAction = "action"
+	// URLQueryPageLimit query key used to limit the size of an eNZi listing.
+	URLQueryPageLimit = "limit"
+
+	// JobActionLDAPSync the eNZi job action which syncs users and team members from LDAP.
+	JobActionLDAPSync = "ldap-sync"
+
+	JobStatusWaiting  = "waiting"
+	JobStatusRunning  = "running"
+	JobStatusDone     = "done"
+	JobStatusCanceled = "canceled"
+	JobStatusErrored  = "errored"
+
+	JobLogLevelInfo    = "info"
+	JobLogLevelWarning = "warning"
+	JobLogLevelError   = "error"
+)
+
+var (
+	ErrJobFailed = errors.New("job did not complete")
+)
+
+// CreateJob struct.
+type CreateJob struct {
+	Action string `json:"action"`
+}
+
+// ResponseJob an eNZi background job.
+type ResponseJob struct {
+	ID          string `json:"id"`
+	Action      string `json:"action"`
+	WorkerID    string `json:"workerID"`
+	Status      string `json:"status"`
+	ScheduledAt string `json:"scheduledAt"`
+	LastUpdated string `json:"lastUpdated"`
+}
+
+// Finished has the job stopped, whether or not it succeeded.
+func (j ResponseJob) Finished() bool {
+	return j.Status == JobStatusDone || j.Status == JobStatusCanceled || j.Status == JobStatusErrored
+}
+
+// ResponseJobs struct.
+type ResponseJobs struct {
+	NextPageStart string        `json:"nextPageStart"`
+	Jobs          []ResponseJob `json:"jobs"`
+}
+
+// JobLogLine a line of output from a job.
+type JobLogLine struct {
+	Level string `json:"level"`
+	Msg   string `json:"msg"`
+	Time  string `json:"time"`
+}
+
+// ResponseJobLogs struct.
+type ResponseJobLogs struct {
+	Lines []JobLogLine `json:"lines"`
+}
+
+// ApiJobCreate schedule a job for an action.
+func (c *Client) ApiJobCreate(ctx context.Context, action string) (ResponseJob, error) {
+	req, err := c.RequestFromTargetAndJSONBody(ctx, http.MethodPost, URLTargetForJobs, CreateJob{Action: action})
+	if err != nil {
+		return ResponseJob{}, fmt.Errorf("creating %s job failed. %w: %s", action, ErrRequestCreation, err)
+	}
+
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return ResponseJob{}, fmt.Errorf("creating %s job failed. %w", action, err)
+	}
+
+	resJob := ResponseJob{}
+	if err := resp.JSONMarshallBody(&resJob); err != nil {
+		return ResponseJob{}, fmt.Errorf("creating %s job failed. %w: %s", action, ErrUnmarshaling, err)
+	}
+	return resJob, nil
+}
+
+// ApiJobRead retrieve a job by ID.
+func (c *Client) ApiJobRead(ctx context.Context, id string) (ResponseJob, error) {
+	url := fmt.Sprintf(URLTargetPatternForJob, id)
+
+	req, err := c.RequestFromTargetAndBytesBody(ctx, http.MethodGet, url, []byte{})
+	if err != nil {
+		return ResponseJob{}, fmt.Errorf("reading job %s failed. %w: %s", id, ErrRequestCreation, err)
+	}
+
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return ResponseJob{}, fmt.Errorf("reading job %s failed. %w", id, err)
+	}
+
+	resJob := ResponseJob{}
+	if err := resp.JSONMarshallBody(&resJob); err != nil {
+		return ResponseJob{}, fmt.Errorf("reading job %s failed. %w: %s", id, ErrUnmarshaling, err)
+	}
+	return resJob, nil
+}
+
+// ApiJobList list the most recent jobs for an action, newest first.
+// Only one page is retrieved, of at most limit jobs, or the eNZi default page size if limit is 0.
+func (c *Client) ApiJobList(ctx context.Context, action string, limit int) ([]ResponseJob, error) {
+	req, err := c.RequestFromTargetAndBytesBody(ctx, http.MethodGet, URLTargetForJobs, []byte{})
+	if err != nil {
+		return []ResponseJob{}, fmt.Errorf("listing %s jobs failed. %w: %s", action, ErrRequestCreation, err)
+	}
+
+	q := req.URL.Query()
+	q.Set(URLQueryJobAction, action)
+	if limit > 0 {
+		q.Set(URLQueryPageLimit, strconv.Itoa(limit))
+	}
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return []ResponseJob{}, fmt.Errorf("listing %s jobs failed. %w", action, err)
+	}
+
+	var page ResponseJobs
+	if err := resp.JSONMarshallBody(&page); err != nil {
+		return []ResponseJob{}, fmt.Errorf("listing %s jobs failed. %w: %s", action, ErrUnmarshaling, err)
+	}
+	return page.Jobs, nil
+}
+
+// ApiJobLogs retrieve the log output of a job.
+func (c *Client) ApiJobLogs(ctx context.Context, id string) ([]JobLogLine, error) {
+	url := fmt.Sprintf(URLTargetPatternForJobLogs, id)
+
+	req, err := c.RequestFromTargetAndBytesBody(ctx, http.MethodGet, url, []byte{})
+	if err != nil {
+		return []JobLogLine{}, fmt.Errorf("reading logs of job %s failed. %w: %s", id, ErrRequestCreation, err)
+	}
+
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return []JobLogLine{}, fmt.Errorf("reading logs of job %s failed. %w", id, err)
+	}
+
+	var logs ResponseJobLogs
+	if err := resp.JSONMarshallBody(&logs); err != nil {
+		return []JobLogLine{}, fmt.Errorf("reading logs of job %s failed. %w: %s", id, ErrUnmarshaling, err)
+	}
+	return logs.Lines, nil
+}
+
+// ApiJobWait poll a job every interval until it has finished, or the context is done.
+// A job which finishes without succeeding is returned with an ErrJobFailed error.
+func (c *Client) ApiJobWait(ctx context.Context, id string, interval time.Duration) (ResponseJob, error) {
+	for {
+		job, err := c.ApiJobRead(ctx, id)
+		if err != nil {
+			return job, err
+		}
+
+		if job.Finished() {
+			if job.Status != JobStatusDone {
+				return job, fmt.Errorf("waiting for job %s failed. %w: %s", id, ErrJobFailed, job.Status)
+			}
+			return job, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return job, fmt.Errorf("waiting for job %s failed, last status %s. %w", id, job.Status, ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+}
+
+// ApiLDAPSyncStart schedule an LDAP sync job.
+func (c *Client) ApiLDAPSyncStart(ctx context.Context) (ResponseJob, error) {
+	return c.ApiJobCreate(ctx, JobActionLDAPSync)
+}
+
+// ApiLDAPSyncLatest retrieve the most recent LDAP sync job, returning false if there has never been one.
+func (c *Client) ApiLDAPSyncLatest(ctx context.Context) (ResponseJob, bool, error) {
+	jobs, err := c.ApiJobList(ctx, JobActionLDAPSync, 1)
+	if err != nil || len(jobs) == 0 {
+		return ResponseJob{}, false, err
+	}
+	return jobs[0], true, nil
+}
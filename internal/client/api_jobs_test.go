@@ -0,0 +1,118 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+func TestJobWaitPollsUntilFinished(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	statuses := []string{client.JobStatusWaiting, client.JobStatusRunning, client.JobStatusDone}
+	reads := 0
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodGet, fmt.Sprintf(client.URLTargetPatternForJob, "job-id"), func(w http.ResponseWriter, r *http.Request) {
+		job := client.ResponseJob{ID: "job-id", Action: client.JobActionLDAPSync, Status: statuses[reads]}
+		reads++
+		MockServerHandlerGeneratorReturnJson(job)(w, r)
+	})
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	job, err := c.ApiJobWait(ctx, "job-id", time.Millisecond)
+	if err != nil {
+		t.Fatalf("wait for job failed: %s", err)
+	}
+	if job.Status != client.JobStatusDone || reads != len(statuses) {
+		t.Errorf("expected the job to be polled until done, got %+v after %d reads", job, reads)
+	}
+}
+
+func TestJobWaitErrored(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodGet, fmt.Sprintf(client.URLTargetPatternForJob, "job-id"), MockServerHandlerGeneratorReturnJson(client.ResponseJob{ID: "job-id", Status: client.JobStatusErrored}))
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	if _, err := c.ApiJobWait(ctx, "job-id", time.Millisecond); !errors.Is(err, client.ErrJobFailed) {
+		t.Errorf("expected a failed job error, got: %v", err)
+	}
+}
+
+func TestJobWaitContextDone(t *testing.T) {
+	auth := commonTestAuth
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodGet, fmt.Sprintf(client.URLTargetPatternForJob, "job-id"), MockServerHandlerGeneratorReturnJson(client.ResponseJob{ID: "job-id", Status: client.JobStatusRunning}))
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if _, err := c.ApiJobWait(ctx, "job-id", time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected a deadline error, got: %v", err)
+	}
+}
+
+func TestLDAPSyncLatest(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	latest := client.ResponseJob{ID: "job-2", Action: client.JobActionLDAPSync, Status: client.JobStatusDone}
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodGet, client.URLTargetForJobs, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get(client.URLQueryJobAction) != client.JobActionLDAPSync || q.Get(client.URLQueryPageLimit) != "1" {
+			t.Errorf("unexpected job list query: %s", r.URL.RawQuery)
+		}
+		MockServerHandlerGeneratorReturnJson(client.ResponseJobs{Jobs: []client.ResponseJob{latest}})(w, r)
+	})
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	job, ok, err := c.ApiLDAPSyncLatest(ctx)
+	if err != nil {
+		t.Fatalf("read latest LDAP sync failed: %s", err)
+	}
+	if !ok || job != latest {
+		t.Errorf("expected (%+v), got (%+v)", latest, job)
+	}
+}
+
+func TestJobLogs(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	lines := []client.JobLogLine{{Level: client.JobLogLevelError, Msg: "unable to bind"}}
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodGet, fmt.Sprintf(client.URLTargetPatternForJobLogs, "job-id"), MockServerHandlerGeneratorReturnJson(client.ResponseJobLogs{Lines: lines}))
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	logs, err := c.ApiJobLogs(ctx, "job-id")
+	if err != nil {
+		t.Fatalf("read job logs failed: %s", err)
+	}
+	if len(logs) != 1 || logs[0] != lines[0] {
+		t.Errorf("expected (%+v), got (%+v)", lines, logs)
+	}
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const (
+	// /config/auth/ldap url.
+	URLTargetForLDAPSettings = "config/auth/ldap"
+	// /config/auth/ldap/tryLogin url.
+	URLTargetForLDAPTryLogin = "config/auth/ldap/tryLogin"
+)
+
+var (
+	ErrLDAPLoginFailed = errors.New("LDAP test login failed")
+)
+
+// LDAPSettings eNZi LDAP authentication backend settings.
+type LDAPSettings struct {
+	ServerURL          string `json:"serverURL"`
+	NoSimplePagination bool   `json:"noSimplePagination"`
+	StartTLS           bool   `json:"startTLS"`
+	RootCerts          string `json:"rootCerts"`
+	TLSSkipVerify      bool   `json:"tlsSkipVerify"`
+	ReaderDN           string `json:"readerDN"`
+	// ReaderPassword is never returned by eNZi, and is left unchanged when omitted from an update.
+	ReaderPassword      string                 `json:"readerPassword,omitempty"`
+	UserSearchConfigs   []LDAPUserSearchConfig `json:"userSearchConfigs"`
+	SyncSchedule        string                 `json:"syncSchedule"`
+	JITUserProvisioning bool                   `json:"jitUserProvisioning"`
+}
+
+// LDAPUserSearchConfig a search for the LDAP users which can log in to MKE.
+type LDAPUserSearchConfig struct {
+	BaseDN               string `json:"baseDN"`
+	ScopeSubtree         bool   `json:"scopeSubtree"`
+	UsernameAttr         string `json:"usernameAttr"`
+	FullNameAttr         string `json:"fullNameAttr"`
+	Filter               string `json:"filter"`
+	MatchGroup           bool   `json:"matchGroup"`
+	MatchGroupDN         string `json:"matchGroupDN"`
+	MatchGroupMemberAttr string `json:"matchGroupMemberAttr"`
+	MatchGroupIterate    bool   `json:"matchGroupIterate"`
+}
+
+// LDAPTryLogin request body for testing a login against LDAP settings, before they are saved.
+type LDAPTryLogin struct {
+	Username     string       `json:"username"`
+	Password     string       `json:"password"`
+	LDAPSettings LDAPSettings `json:"ldapSettings"`
+}
+
+// LDAPTryLoginResponse result of a test login.
+type LDAPTryLoginResponse struct {
+	ErrorMessage string   `json:"errorMessage"`
+	Logs         []string `json:"logs"`
+}
+
+// ApiLDAPSettingsRead retrieve the LDAP settings of the cluster.
+func (c *Client) ApiLDAPSettingsRead(ctx context.Context) (LDAPSettings, error) {
+	req, err := c.RequestFromTargetAndBytesBody(ctx, http.MethodGet, URLTargetForLDAPSettings, []byte{})
+	if err != nil {
+		return LDAPSettings{}, fmt.Errorf("reading LDAP settings failed. %w: %s", ErrRequestCreation, err)
+	}
+
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return LDAPSettings{}, fmt.Errorf("reading LDAP settings failed. %w", err)
+	}
+
+	settings := LDAPSettings{}
+	if err := resp.JSONMarshallBody(&settings); err != nil {
+		return LDAPSettings{}, fmt.Errorf("reading LDAP settings failed. %w: %s", ErrUnmarshaling, err)
+	}
+	return settings, nil
+}
+
+// ApiLDAPSettingsUpdate replace the LDAP settings of the cluster.
+func (c *Client) ApiLDAPSettingsUpdate(ctx context.Context, settings LDAPSettings) (LDAPSettings, error) {
+	req, err := c.RequestFromTargetAndJSONBody(ctx, http.MethodPut, URLTargetForLDAPSettings, settings)
+	if err != nil {
+		return LDAPSettings{}, fmt.Errorf("updating LDAP settings failed. %w: %s", ErrRequestCreation, err)
+	}
+
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return LDAPSettings{}, fmt.Errorf("updating LDAP settings failed. %w", err)
+	}
+
+	resSettings := LDAPSettings{}
+	if err := resp.JSONMarshallBody(&resSettings); err != nil {
+		return LDAPSettings{}, fmt.Errorf("updating LDAP settings failed. %w: %s", ErrUnmarshaling, err)
+	}
+	return resSettings, nil
+}
+
+// ApiLDAPTryLogin test logging in to LDAP with the passed settings, without saving them.
+// A login which LDAP rejects is returned as an ErrLDAPLoginFailed error with the eNZi message.
+func (c *Client) ApiLDAPTryLogin(ctx context.Context, try LDAPTryLogin) (LDAPTryLoginResponse, error) {
+	req, err := c.RequestFromTargetAndJSONBody(ctx, http.MethodPost, URLTargetForLDAPTryLogin, try)
+	if err != nil {
+		return LDAPTryLoginResponse{}, fmt.Errorf("LDAP test login for %s failed. %w: %s", try.Username, ErrRequestCreation, err)
+	}
+
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return LDAPTryLoginResponse{}, fmt.Errorf("LDAP test login for %s failed. %w", try.Username, err)
+	}
+
+	resTry := LDAPTryLoginResponse{}
+	if err := resp.JSONMarshallBody(&resTry); err != nil {
+		return LDAPTryLoginResponse{}, fmt.Errorf("LDAP test login for %s failed. %w: %s", try.Username, ErrUnmarshaling, err)
+	}
+
+	if resTry.ErrorMessage != "" {
+		return resTry, fmt.Errorf("%w for %s: %s", ErrLDAPLoginFailed, try.Username, resTry.ErrorMessage)
+	}
+	return resTry, nil
+}
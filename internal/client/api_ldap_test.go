@@ -0,0 +1,80 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+func TestLDAPSettingsUpdateOmitsEmptyPassword(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	settings := client.LDAPSettings{ServerURL: "ldaps://ldap.example.com", ReaderDN: "cn=reader"}
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodPut, client.URLTargetForLDAPSettings, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(b), "readerPassword") {
+			t.Errorf("an empty reader password should not be sent: %s", b)
+		}
+		MockServerHandlerGeneratorReturnJson(settings)(w, r)
+	})
+	s.AddHandler(http.MethodGet, client.URLTargetForLDAPSettings, MockServerHandlerGeneratorReturnJson(settings))
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	if _, err := c.ApiLDAPSettingsUpdate(ctx, settings); err != nil {
+		t.Fatalf("update LDAP settings failed: %s", err)
+	}
+
+	read, err := c.ApiLDAPSettingsRead(ctx)
+	if err != nil {
+		t.Fatalf("read LDAP settings failed: %s", err)
+	}
+	if read.ServerURL != settings.ServerURL || read.ReaderDN != settings.ReaderDN {
+		t.Errorf("expected (%+v), got (%+v)", settings, read)
+	}
+}
+
+func TestLDAPTryLogin(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodPost, client.URLTargetForLDAPTryLogin, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		var try client.LDAPTryLogin
+		if err := json.Unmarshal(b, &try); err != nil {
+			t.Errorf("try login body was not json: %s", b)
+		}
+
+		res := client.LDAPTryLoginResponse{Logs: []string{"searching"}}
+		if try.Password != "right" {
+			res.ErrorMessage = "invalid credentials"
+		}
+		MockServerHandlerGeneratorReturnJson(res)(w, r)
+	})
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	if _, err := c.ApiLDAPTryLogin(ctx, client.LDAPTryLogin{Username: "user", Password: "right"}); err != nil {
+		t.Errorf("expected the test login to succeed: %s", err)
+	}
+
+	res, err := c.ApiLDAPTryLogin(ctx, client.LDAPTryLogin{Username: "user", Password: "wrong"})
+	if !errors.Is(err, client.ErrLDAPLoginFailed) {
+		t.Errorf("expected a failed login error, got: %v", err)
+	}
+	if len(res.Logs) != 1 {
+		t.Errorf("expected the test login logs to be returned: %+v", res)
+	}
+}
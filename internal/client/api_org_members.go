@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	// /accounts/{orgNameOrID}/members url.
+	URLTargetPatternForOrgMembers = "accounts/%s/members"
+	// /accounts/{orgNameOrID}/members/{memberNameOrID} url.
+	URLTargetPatternForOrgMember = "accounts/%s/members/%s"
+	// /accounts/{orgNameOrID}/members/{memberNameOrID}/teams url.
+	URLTargetPatternForOrgMemberTeams = "accounts/%s/members/%s/teams"
+	// /accounts/{accountNameOrID}/organizations url.
+	URLTargetPatternForAccountOrgs = "accounts/%s/organizations"
+)
+
+// ResponseMemberOrg an organization which an account is a member of, and whether it administers it.
+type ResponseMemberOrg struct {
+	Org     ResponseAccount `json:"org"`
+	IsAdmin bool            `json:"isAdmin"`
+}
+
+// ResponseMemberOrgs struct.
+type ResponseMemberOrgs struct {
+	NextPageStart string              `json:"nextPageStart"`
+	MemberOrgs    []ResponseMemberOrg `json:"memberOrgs"`
+}
+
+// ApiOrgMemberList list all of the members of an organization, following pagination.
+func (c *Client) ApiOrgMemberList(ctx context.Context, org string) ([]ResponseMember, error) {
+	u := fmt.Sprintf(URLTargetPatternForOrgMembers, org)
+
+	members, err := listAllPages(ctx, c, u, nil, func(p ResponseMembers) ([]ResponseMember, string) {
+		return p.Members, p.NextPageStart
+	})
+	if err != nil {
+		return members, fmt.Errorf("listing members of org %s failed. %w", org, err)
+	}
+
+	return members, nil
+}
+
+// ApiOrgMemberRead retrieve the membership of a single account in an organization.
+func (c *Client) ApiOrgMemberRead(ctx context.Context, org, member string) (ResponseMember, error) {
+	u := fmt.Sprintf(URLTargetPatternForOrgMember, org, member)
+
+	req, err := c.RequestFromTargetAndBytesBody(ctx, http.MethodGet, u, []byte{})
+	if err != nil {
+		return ResponseMember{}, fmt.Errorf("reading member %s of org %s failed. %w: %s", member, org, ErrRequestCreation, err)
+	}
+
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return ResponseMember{}, fmt.Errorf("reading member %s of org %s failed. %w", member, org, err)
+	}
+
+	resMember := ResponseMember{}
+	if err := resp.JSONMarshallBody(&resMember); err != nil {
+		return ResponseMember{}, fmt.Errorf("reading member %s of org %s failed. %w: %s", member, org, ErrUnmarshaling, err)
+	}
+	return resMember, nil
+}
+
+// ApiOrgMemberSet add an account to an organization, or update its org admin flag if it is already a member.
+func (c *Client) ApiOrgMemberSet(ctx context.Context, org, member string, isAdmin bool) (ResponseMember, error) {
+	u := fmt.Sprintf(URLTargetPatternForOrgMember, org, member)
+
+	req, err := c.RequestFromTargetAndJSONBody(ctx, http.MethodPut, u, MemberForm{IsAdmin: isAdmin})
+	if err != nil {
+		return ResponseMember{}, fmt.Errorf("setting member %s of org %s failed. %w: %s", member, org, ErrRequestCreation, err)
+	}
+
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return ResponseMember{}, fmt.Errorf("setting member %s of org %s failed. %w", member, org, err)
+	}
+
+	resMember := ResponseMember{}
+	if err := resp.JSONMarshallBody(&resMember); err != nil {
+		return ResponseMember{}, fmt.Errorf("setting member %s of org %s failed. %w: %s", member, org, ErrUnmarshaling, err)
+	}
+	return resMember, nil
+}
+
+// ApiOrgMemberRemove remove an account from an organization, which also removes it from all of the org teams.
+func (c *Client) ApiOrgMemberRemove(ctx context.Context, org, member string) error {
+	u := fmt.Sprintf(URLTargetPatternForOrgMember, org, member)
+
+	req, err := c.RequestFromTargetAndBytesBody(ctx, http.MethodDelete, u, []byte{})
+	if err != nil {
+		return fmt.Errorf("removing member %s of org %s failed. %w: %s", member, org, ErrRequestCreation, err)
+	}
+
+	if _, err = c.doAuthorizedRequest(req); err != nil {
+		return fmt.Errorf("removing member %s of org %s failed. %w", member, org, err)
+	}
+	return nil
+}
+
+// ApiOrgMemberTeams list the teams of an organization which an account is a member of, following pagination.
+func (c *Client) ApiOrgMemberTeams(ctx context.Context, org, member string) ([]ResponseTeam, error) {
+	u := fmt.Sprintf(URLTargetPatternForOrgMemberTeams, org, member)
+
+	teams, err := listAllPages(ctx, c, u, nil, func(p ResponseTeams) ([]ResponseTeam, string) {
+		return p.Teams, p.NextPageStart
+	})
+	if err != nil {
+		return teams, fmt.Errorf("listing teams of member %s in org %s failed. %w", member, org, err)
+	}
+
+	return teams, nil
+}
+
+// ApiAccountOrgs list the organizations which an account is a member of, following pagination.
+func (c *Client) ApiAccountOrgs(ctx context.Context, account string) ([]ResponseMemberOrg, error) {
+	u := fmt.Sprintf(URLTargetPatternForAccountOrgs, account)
+
+	orgs, err := listAllPages(ctx, c, u, nil, func(p ResponseMemberOrgs) ([]ResponseMemberOrg, string) {
+		return p.MemberOrgs, p.NextPageStart
+	})
+	if err != nil {
+		return orgs, fmt.Errorf("listing orgs of account %s failed. %w", account, err)
+	}
+
+	return orgs, nil
+}
@@ -0,0 +1,101 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+func TestOrgMemberSetSendsAdminFlag(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodPut, fmt.Sprintf(client.URLTargetPatternForOrgMember, "myorg", "user1"), func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		var f client.MemberForm
+		if err := json.Unmarshal(b, &f); err != nil || !f.IsAdmin {
+			t.Errorf("expected an org admin member form, got: %s", b)
+		}
+		MockServerHandlerGeneratorReturnJson(member("user1", true))(w, r)
+	})
+	s.AddHandler(http.MethodGet, fmt.Sprintf(client.URLTargetPatternForOrgMember, "myorg", "user2"), MockServerHandlerGeneratorReturnResponseStatus(http.StatusNotFound))
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	m, err := c.ApiOrgMemberSet(ctx, "myorg", "user1", true)
+	if err != nil {
+		t.Fatalf("set org member failed: %s", err)
+	}
+	if !m.IsAdmin || m.Member.Name != "user1" {
+		t.Errorf("unexpected org member: %+v", m)
+	}
+
+	if _, err := c.ApiOrgMemberRead(ctx, "myorg", "user2"); !client.IsNotFound(err) {
+		t.Errorf("expected a not found error, got: %v", err)
+	}
+}
+
+func TestOrgMemberTeamsPaginates(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	pages := map[string]client.ResponseTeams{
+		"":      {NextPageStart: "team2", Teams: []client.ResponseTeam{{Name: "team1"}}},
+		"team2": {Teams: []client.ResponseTeam{{Name: "team2"}}},
+	}
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodGet, fmt.Sprintf(client.URLTargetPatternForOrgMemberTeams, "myorg", "user1"), func(w http.ResponseWriter, r *http.Request) {
+		if authHeaders := r.Header.Values(client.HeaderKeyAuthorization); len(authHeaders) != 1 {
+			t.Errorf("expected one authorization header on every page, got %d", len(authHeaders))
+		}
+		MockServerHandlerGeneratorReturnJson(pages[r.URL.Query().Get(client.URLQueryPageStart)])(w, r)
+	})
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	teams, err := c.ApiOrgMemberTeams(ctx, "myorg", "user1")
+	if err != nil {
+		t.Fatalf("list member teams failed: %s", err)
+	}
+	if len(teams) != 2 {
+		t.Errorf("expected both teams across pages, got %+v", teams)
+	}
+}
+
+func TestAccountOrgsPaginates(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	pages := map[string]client.ResponseMemberOrgs{
+		"":     {NextPageStart: "org2", MemberOrgs: []client.ResponseMemberOrg{{Org: client.ResponseAccount{Name: "org1", IsOrg: true}, IsAdmin: true}}},
+		"org2": {MemberOrgs: []client.ResponseMemberOrg{{Org: client.ResponseAccount{Name: "org2", IsOrg: true}}}},
+	}
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodGet, fmt.Sprintf(client.URLTargetPatternForAccountOrgs, "user1"), func(w http.ResponseWriter, r *http.Request) {
+		if authHeaders := r.Header.Values(client.HeaderKeyAuthorization); len(authHeaders) != 1 {
+			t.Errorf("expected one authorization header on every page, got %d", len(authHeaders))
+		}
+		MockServerHandlerGeneratorReturnJson(pages[r.URL.Query().Get(client.URLQueryPageStart)])(w, r)
+	})
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	orgs, err := c.ApiAccountOrgs(ctx, "user1")
+	if err != nil {
+		t.Fatalf("list account orgs failed: %s", err)
+	}
+	if len(orgs) != 2 || !orgs[0].IsAdmin || orgs[1].Org.Name != "org2" {
+		t.Errorf("expected both orgs across pages, got %+v", orgs)
+	}
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+var (
+	ErrAccountIsNotOrg = errors.New("account is not an organization")
+)
+
+// CreateOrg struct.
+type CreateOrg struct {
+	Name     string `json:"name"`
+	FullName string `json:"fullName,omitempty"`
+	IsOrg    bool   `json:"isOrg"`
+}
+
+// UpdateOrg struct.
+type UpdateOrg struct {
+	FullName string `json:"fullName"`
+}
+
+// ApiCreateOrg create an organization account in eNZi.
+func (c *Client) ApiCreateOrg(ctx context.Context, org CreateOrg) (ResponseAccount, error) {
+	if org.Name == "" {
+		return ResponseAccount{}, fmt.Errorf("creating org failed. %w: %+v", ErrEmptyStruct, org)
+	}
+	org.IsOrg = true
+
+	req, err := c.RequestFromTargetAndJSONBody(ctx, http.MethodPost, URLTargetForAccounts, org)
+	if err != nil {
+		return ResponseAccount{}, fmt.Errorf("creating org %s failed. %w: %s", org.Name, ErrRequestCreation, err)
+	}
+
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return ResponseAccount{}, fmt.Errorf("creating org %s failed. %w", org.Name, err)
+	}
+
+	resOrg := ResponseAccount{}
+	if err := resp.JSONMarshallBody(&resOrg); err != nil {
+		return ResponseAccount{}, fmt.Errorf("creating org %s failed. %w: %s", org.Name, ErrUnmarshaling, err)
+	}
+
+	return resOrg, nil
+}
+
+// ApiReadOrg retrieve an organization by name or ID, failing if the account is not an org.
+func (c *Client) ApiReadOrg(ctx context.Context, nameOrID string) (ResponseAccount, error) {
+	acc, err := c.ApiReadAccount(ctx, nameOrID)
+	if err != nil {
+		return ResponseAccount{}, err
+	}
+
+	if !acc.IsOrg {
+		return ResponseAccount{}, fmt.Errorf("reading org %s failed. %w", nameOrID, ErrAccountIsNotOrg)
+	}
+
+	return acc, nil
+}
+
+// ApiOrgList list all of the organizations, following pagination.
+func (c *Client) ApiOrgList(ctx context.Context) ([]ResponseAccount, error) {
+	return c.ApiReadAccounts(ctx, AccountFilterOrgs)
+}
+
+// ApiUpdateOrg update an organization in eNZi.
+func (c *Client) ApiUpdateOrg(ctx context.Context, nameOrID string, org UpdateOrg) (ResponseAccount, error) {
+	url := fmt.Sprintf("%s/%s", URLTargetForAccounts, nameOrID)
+
+	req, err := c.RequestFromTargetAndJSONBody(ctx, http.MethodPatch, url, org)
+	if err != nil {
+		return ResponseAccount{}, fmt.Errorf("updating org %s failed. %w: %s", nameOrID, ErrRequestCreation, err)
+	}
+
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return ResponseAccount{}, fmt.Errorf("updating org %s failed. %w", nameOrID, err)
+	}
+
+	resOrg := ResponseAccount{}
+	if err := resp.JSONMarshallBody(&resOrg); err != nil {
+		return ResponseAccount{}, fmt.Errorf("updating org %s failed. %w: %s", nameOrID, ErrUnmarshaling, err)
+	}
+	return resOrg, nil
+}
+
+// ApiDeleteOrg delete an organization, and with it all of its teams and memberships.
+func (c *Client) ApiDeleteOrg(ctx context.Context, nameOrID string) error {
+	url := fmt.Sprintf("%s/%s", URLTargetForAccounts, nameOrID)
+	req, err := c.RequestFromTargetAndBytesBody(ctx, http.MethodDelete, url, []byte{})
+	if err != nil {
+		return fmt.Errorf("deleting org %s failed. %w: %s", nameOrID, ErrRequestCreation, err)
+	}
+
+	if _, err = c.doAuthorizedRequest(req); err != nil {
+		return fmt.Errorf("deleting org %s failed. %w", nameOrID, err)
+	}
+	return nil
+}
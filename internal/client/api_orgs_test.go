@@ -0,0 +1,114 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+func TestCreateOrgSendsIsOrg(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	expectedOrg := client.ResponseAccount{ID: "org-id", Name: "myorg", IsOrg: true}
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodPost, client.URLTargetForAccounts, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+
+		var body map[string]interface{}
+		if err := json.Unmarshal(b, &body); err != nil {
+			t.Errorf("create org body was not json: %s", b)
+		}
+		if body["isOrg"] != true {
+			t.Errorf("create org request was not marked as an org: %s", b)
+		}
+		if _, ok := body["password"]; ok {
+			t.Errorf("create org request should not have a password: %s", b)
+		}
+
+		MockServerHandlerGeneratorReturnJson(expectedOrg)(w, r)
+	})
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	resp, err := c.ApiCreateOrg(ctx, client.CreateOrg{Name: "myorg"})
+	if err != nil {
+		t.Fatalf("create org failed: %s", err)
+	}
+	if resp != expectedOrg {
+		t.Errorf("expected (%+v), got (%+v)", expectedOrg, resp)
+	}
+}
+
+func TestReadOrgRejectsUser(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodGet, fmt.Sprintf("%s/%s", client.URLTargetForAccounts, "someuser"), MockServerHandlerGeneratorReturnJson(client.ResponseAccount{Name: "someuser"}))
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	if _, err := c.ApiReadOrg(ctx, "someuser"); !errors.Is(err, client.ErrAccountIsNotOrg) {
+		t.Errorf("expected a not an org error, got: %v", err)
+	}
+}
+
+func TestUpdateAndDeleteOrg(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	org := "myorg"
+	expectedOrg := client.ResponseAccount{Name: org, FullName: "My Org", IsOrg: true}
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodPatch, fmt.Sprintf("%s/%s", client.URLTargetForAccounts, org), MockServerHandlerGeneratorReturnJson(expectedOrg))
+	s.AddHandler(http.MethodDelete, fmt.Sprintf("%s/%s", client.URLTargetForAccounts, org), MockServerHandlerGeneratorReturnResponseStatus(http.StatusNoContent))
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	if resp, err := c.ApiUpdateOrg(ctx, org, client.UpdateOrg{FullName: "My Org"}); err != nil {
+		t.Errorf("update org failed: %s", err)
+	} else if resp != expectedOrg {
+		t.Errorf("expected (%+v), got (%+v)", expectedOrg, resp)
+	}
+
+	if err := c.ApiDeleteOrg(ctx, org); err != nil {
+		t.Errorf("delete org failed: %s", err)
+	}
+}
+
+func TestOrgListSendsOrgsFilter(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	expectedOrgs := []client.ResponseAccount{{Name: "org1", IsOrg: true}}
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodGet, client.URLTargetForAccounts, func(w http.ResponseWriter, r *http.Request) {
+		if filter := r.URL.Query().Get("filter"); filter != "orgs" {
+			t.Errorf("expected the orgs filter, got %q", filter)
+		}
+		MockServerHandlerGeneratorReturnJson(client.ResponseAccounts{Accounts: expectedOrgs})(w, r)
+	})
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	if orgs, err := c.ApiOrgList(ctx); err != nil {
+		t.Errorf("list orgs failed: %s", err)
+	} else if !reflect.DeepEqual(expectedOrgs, orgs) {
+		t.Errorf("expected (%+v), got (%+v)", expectedOrgs, orgs)
+	}
+}
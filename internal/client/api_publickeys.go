@@ -13,6 +13,18 @@ const (
 	URLTargetPatternForPublicKey = "accounts/%s/publicKeys/%s"
 )
 
+// CreatePublicKey uploads a public key which was made outside of MKE, e.g. in an HSM.
+type CreatePublicKey struct {
+	PublicKey    string        `json:"publicKey"`
+	Label        string        `json:"label,omitempty"`
+	Certificates []Certificate `json:"certificates,omitempty"`
+}
+
+// UpdatePublicKey changes the label of a public key.
+type UpdatePublicKey struct {
+	Label string `json:"label"`
+}
+
 type GetKeysResponse struct {
 	AccountPubKeys []AccountPublicKey `json:"accountPublicKeys"`
 	NextPageStart  string             `json:"nextPageStart"`
@@ -22,47 +34,54 @@ type GetKeysResponse struct {
 func (c *Client) ApiPublicKeyList(ctx context.Context, account string) ([]AccountPublicKey, error) {
 	u := fmt.Sprintf(URLTargetPatternForPublicKeys, account)
 
-	var keys []AccountPublicKey
+	return listAllPages(ctx, c, u, nil, func(p GetKeysResponse) ([]AccountPublicKey, string) {
+		return p.AccountPubKeys, p.NextPageStart
+	})
+}
+
+// ApiPublicKeyRetrieve retrieve a specific account key.
+func (c *Client) ApiPublicKeyRetrieve(ctx context.Context, account, keyid string) (AccountPublicKey, error) {
+	u := fmt.Sprintf(URLTargetPatternForPublicKey, account, keyid)
+
+	var k AccountPublicKey
 
 	req, err := c.RequestFromTargetAndBytesBody(ctx, http.MethodGet, u, []byte{})
 	if err != nil {
-		return keys, err
+		return k, err
 	}
 
-	for {
-		resp, err := c.doAuthorizedRequest(req)
-		if err != nil {
-			return keys, err
-		}
-
-		var respContents GetKeysResponse
-
-		if err := resp.JSONMarshallBody(&respContents); err != nil {
-			return keys, err
-		}
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return k, err
+	}
 
-		keys = append(keys, respContents.AccountPubKeys...)
+	if err = resp.JSONMarshallBody(&k); err != nil {
+		return k, err
+	}
 
-		if respContents.NextPageStart == "" {
-			break
-		}
+	return k, nil
+}
 
-		reqQuery := req.URL.Query()
-		reqQuery.Set("age", respContents.NextPageStart)
-		req.URL.RawQuery = reqQuery.Encode()
+// ApiPublicKeyDelete delete a specific account key.
+func (c *Client) ApiPublicKeyDelete(ctx context.Context, account, keyid string) error {
+	u := fmt.Sprintf(URLTargetPatternForPublicKey, account, keyid)
 
+	req, err := c.RequestFromTargetAndBytesBody(ctx, http.MethodDelete, u, []byte{})
+	if err != nil {
+		return err
 	}
 
-	return keys, nil
+	_, err = c.doAuthorizedRequest(req)
+	return err
 }
 
-// ApiPublicKeyRetrieve retrieve a specific account key.
-func (c *Client) ApiPublicKeyRetrieve(ctx context.Context, account, keyid string) (AccountPublicKey, error) {
-	u := fmt.Sprintf(URLTargetPatternForPublicKey, account, keyid)
+// ApiPublicKeyCreate upload a public key, and optionally its certificates, for an account.
+func (c *Client) ApiPublicKeyCreate(ctx context.Context, account string, key CreatePublicKey) (AccountPublicKey, error) {
+	u := fmt.Sprintf(URLTargetPatternForPublicKeys, account)
 
 	var k AccountPublicKey
 
-	req, err := c.RequestFromTargetAndBytesBody(ctx, http.MethodGet, u, []byte{})
+	req, err := c.RequestFromTargetAndJSONBody(ctx, http.MethodPost, u, key)
 	if err != nil {
 		return k, err
 	}
@@ -79,15 +98,25 @@ func (c *Client) ApiPublicKeyRetrieve(ctx context.Context, account, keyid string
 	return k, nil
 }
 
-// ApiPublicKeyDelete delete a specific account key.
-func (c *Client) ApiPublicKeyDelete(ctx context.Context, account, keyid string) error {
+// ApiPublicKeyUpdate update the label of a specific account key.
+func (c *Client) ApiPublicKeyUpdate(ctx context.Context, account, keyid string, key UpdatePublicKey) (AccountPublicKey, error) {
 	u := fmt.Sprintf(URLTargetPatternForPublicKey, account, keyid)
 
-	req, err := c.RequestFromTargetAndBytesBody(ctx, http.MethodDelete, u, []byte{})
+	var k AccountPublicKey
+
+	req, err := c.RequestFromTargetAndJSONBody(ctx, http.MethodPatch, u, key)
 	if err != nil {
-		return err
+		return k, err
 	}
 
-	_, err = c.doAuthorizedRequest(req)
-	return err
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return k, err
+	}
+
+	if err = resp.JSONMarshallBody(&k); err != nil {
+		return k, err
+	}
+
+	return k, nil
 }
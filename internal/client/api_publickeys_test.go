@@ -2,8 +2,11 @@ package client_test
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"reflect"
 	"testing"
 
 	"github.com/Mirantis/terraform-provider-mke/internal/client"
@@ -37,6 +40,104 @@ func TestSimpleGetKeys(t *testing.T) {
 
 }
 
+func TestGetKeysPaginates(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	// one more key than fits on a page of the default MKE page size
+	var all []client.AccountPublicKey
+	for i := 0; i < 101; i++ {
+		all = append(all, client.AccountPublicKey{ID: fmt.Sprintf("key%03d", i)})
+	}
+	pages := map[string]client.GetKeysResponse{
+		"":       {AccountPubKeys: all[:100], NextPageStart: "key100"},
+		"key100": {AccountPubKeys: all[100:]},
+	}
+
+	requests := 0
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodGet, fmt.Sprintf(client.URLTargetPatternForPublicKeys, auth.Username), func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests > len(pages) {
+			// stop a client which does not follow the next page
+			MockServerHandlerGeneratorReturnResponseStatus(http.StatusBadRequest)(w, r)
+			return
+		}
+		if authHeaders := r.Header.Values(client.HeaderKeyAuthorization); len(authHeaders) != 1 {
+			t.Errorf("expected one authorization header on every page, got %d", len(authHeaders))
+		}
+		MockServerHandlerGeneratorReturnJson(pages[r.URL.Query().Get(client.URLQueryPageStart)])(w, r)
+	})
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	keys, err := c.ApiPublicKeyList(ctx, auth.Username)
+	if err != nil {
+		t.Fatalf("get keys request failed: %s", err)
+	}
+	if !reflect.DeepEqual(keys, all) {
+		t.Errorf("expected all %d keys, got %d", len(all), len(keys))
+	}
+	if requests != len(pages) {
+		t.Errorf("expected %d page requests, got %d", len(pages), requests)
+	}
+}
+
+func TestCreateKeySendsCertificates(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	create := client.CreatePublicKey{
+		PublicKey:    "PUBLIC KEY PEM",
+		Label:        "hsm",
+		Certificates: []client.Certificate{{Label: "service", Cert: "CERT PEM"}},
+	}
+	expected := client.AccountPublicKey{ID: "ASDF", PublicKey: create.PublicKey, Label: create.Label, Certificates: create.Certificates}
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodPost, fmt.Sprintf(client.URLTargetPatternForPublicKeys, "service"), func(w http.ResponseWriter, r *http.Request) {
+		var body client.CreatePublicKey
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("create key body was not json: %s", err)
+		}
+		if !reflect.DeepEqual(create, body) {
+			t.Errorf("expected body (%+v), got (%+v)", create, body)
+		}
+		MockServerHandlerGeneratorReturnJson(expected)(w, r)
+	})
+
+	c, _ := s.Client()
+
+	key, err := c.ApiPublicKeyCreate(ctx, "service", create)
+	if err != nil {
+		t.Fatalf("create key request failed: %s", err)
+	}
+	if !reflect.DeepEqual(expected, key) {
+		t.Errorf("expected key (%+v), got (%+v)", expected, key)
+	}
+}
+
+func TestUpdateKeyLabel(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+	keyID := "ASDFASDF"
+	expected := client.AccountPublicKey{ID: keyID, Label: "renamed"}
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodPatch, fmt.Sprintf(client.URLTargetPatternForPublicKey, "service", keyID), MockServerHandlerGeneratorReturnJson(expected))
+
+	c, _ := s.Client()
+
+	key, err := c.ApiPublicKeyUpdate(ctx, "service", keyID, client.UpdatePublicKey{Label: "renamed"})
+	if err != nil {
+		t.Fatalf("update key request failed: %s", err)
+	}
+	if !reflect.DeepEqual(expected, key) {
+		t.Errorf("expected key (%+v), got (%+v)", expected, key)
+	}
+}
+
 func TestDeleteKeySuccess(t *testing.T) {
 	ctx := context.Background()
 	auth := commonTestAuth
@@ -66,3 +167,27 @@ func TestDeleteKeyFailure(t *testing.T) {
 		t.Fatalf("Did not receive expected delete error")
 	}
 }
+
+func TestClientBundlePublicKeyNotFound(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+	keysResp := client.GetKeysResponse{
+		AccountPubKeys: []client.AccountPublicKey{
+			{ID: "ASDF", PublicKey: "some other key"},
+		},
+	}
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodGet, fmt.Sprintf(client.URLTargetPatternForPublicKeys, auth.Username), MockServerHandlerGeneratorReturnJson(keysResp))
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	_, err := c.ApiClientBundleGetPublicKey(ctx, client.ClientBundle{PublicKey: "my key"})
+	if !errors.Is(err, client.ErrFailedToFindClientBundleMKEPublicKey) {
+		t.Errorf("expected a missing key error, got: %v", err)
+	}
+	if !client.IsNotFound(err) {
+		t.Errorf("missing client bundle key should be a not found error: %v", err)
+	}
+}
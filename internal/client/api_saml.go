@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	// /config/auth/saml url.
+	URLTargetForSAMLSettings = "config/auth/saml"
+	// /saml/metadata url, the service provider metadata which is given to the IdP.
+	URLTargetForSAMLMetadata = "saml/metadata"
+)
+
+// SAMLSettings eNZi SAML authentication backend settings.
+type SAMLSettings struct {
+	Enabled        bool   `json:"enabled"`
+	IdPMetadataURL string `json:"idpMetadataURL"`
+	SPHost         string `json:"spHost"`
+	RootCerts      string `json:"rootCerts"`
+	TLSSkipVerify  bool   `json:"tlsSkipVerify"`
+	// TeamMappings are applied to users each time they log in with SAML.
+	TeamMappings []SAMLTeamMapping `json:"teamMappings"`
+}
+
+// SAMLTeamMapping add users with a SAML assertion attribute value to an org, or to a team in the org.
+type SAMLTeamMapping struct {
+	AttributeName  string `json:"attributeName"`
+	AttributeValue string `json:"attributeValue"`
+	Org            string `json:"org"`
+	// Team is empty for a mapping to org membership only.
+	Team string `json:"team,omitempty"`
+}
+
+// ApiSAMLSettingsRead retrieve the SAML settings of the cluster.
+func (c *Client) ApiSAMLSettingsRead(ctx context.Context) (SAMLSettings, error) {
+	req, err := c.RequestFromTargetAndBytesBody(ctx, http.MethodGet, URLTargetForSAMLSettings, []byte{})
+	if err != nil {
+		return SAMLSettings{}, fmt.Errorf("reading SAML settings failed. %w: %s", ErrRequestCreation, err)
+	}
+
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return SAMLSettings{}, fmt.Errorf("reading SAML settings failed. %w", err)
+	}
+
+	settings := SAMLSettings{}
+	if err := resp.JSONMarshallBody(&settings); err != nil {
+		return SAMLSettings{}, fmt.Errorf("reading SAML settings failed. %w: %s", ErrUnmarshaling, err)
+	}
+	return settings, nil
+}
+
+// ApiSAMLSettingsUpdate replace the SAML settings of the cluster.
+func (c *Client) ApiSAMLSettingsUpdate(ctx context.Context, settings SAMLSettings) (SAMLSettings, error) {
+	req, err := c.RequestFromTargetAndJSONBody(ctx, http.MethodPut, URLTargetForSAMLSettings, settings)
+	if err != nil {
+		return SAMLSettings{}, fmt.Errorf("updating SAML settings failed. %w: %s", ErrRequestCreation, err)
+	}
+
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return SAMLSettings{}, fmt.Errorf("updating SAML settings failed. %w", err)
+	}
+
+	resSettings := SAMLSettings{}
+	if err := resp.JSONMarshallBody(&resSettings); err != nil {
+		return SAMLSettings{}, fmt.Errorf("updating SAML settings failed. %w: %s", ErrUnmarshaling, err)
+	}
+	return resSettings, nil
+}
+
+// ApiSAMLMetadata retrieve the service provider metadata XML of the cluster.
+func (c *Client) ApiSAMLMetadata(ctx context.Context) (string, error) {
+	req, err := c.RequestFromTargetAndBytesBody(ctx, http.MethodGet, URLTargetForSAMLMetadata, []byte{})
+	if err != nil {
+		return "", fmt.Errorf("reading SAML metadata failed. %w: %s", ErrRequestCreation, err)
+	}
+
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return "", fmt.Errorf("reading SAML metadata failed. %w", err)
+	}
+	defer resp.Body.Close()
+
+	b, err := resp.BodyBytes()
+	if err != nil {
+		return "", fmt.Errorf("reading SAML metadata failed. %w: %s", ErrUnmarshaling, err)
+	}
+	return string(b), nil
+}
@@ -0,0 +1,63 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+func TestSAMLSettings(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	settings := client.SAMLSettings{
+		Enabled:        true,
+		IdPMetadataURL: "https://idp.example.com/metadata",
+		SPHost:         "https://mke.example.com",
+		TeamMappings: []client.SAMLTeamMapping{
+			{AttributeName: "groups", AttributeValue: "devs", Org: "engineering", Team: "devs"},
+		},
+	}
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodPut, client.URLTargetForSAMLSettings, MockServerHandlerGeneratorReturnJson(settings))
+	s.AddHandler(http.MethodGet, client.URLTargetForSAMLSettings, MockServerHandlerGeneratorReturnJson(settings))
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	if _, err := c.ApiSAMLSettingsUpdate(ctx, settings); err != nil {
+		t.Fatalf("update SAML settings failed: %s", err)
+	}
+
+	read, err := c.ApiSAMLSettingsRead(ctx)
+	if err != nil {
+		t.Fatalf("read SAML settings failed: %s", err)
+	}
+	if read.IdPMetadataURL != settings.IdPMetadataURL || len(read.TeamMappings) != 1 || read.TeamMappings[0] != settings.TeamMappings[0] {
+		t.Errorf("expected (%+v), got (%+v)", settings, read)
+	}
+}
+
+func TestSAMLMetadata(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	metadata := `<EntityDescriptor entityID="https://mke.example.com/enzi/v0/saml/metadata"></EntityDescriptor>`
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodGet, client.URLTargetForSAMLMetadata, MockServerHandlerGeneratorReturnBytes([]byte(metadata)))
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	read, err := c.ApiSAMLMetadata(ctx)
+	if err != nil {
+		t.Fatalf("read SAML metadata failed: %s", err)
+	}
+	if read != metadata {
+		t.Errorf("expected (%s), got (%s)", metadata, read)
+	}
+}
@@ -0,0 +1,152 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	// /config/auth/scim url.
+	URLTargetForSCIMSettings = "config/auth/scim"
+	// /config/auth/scim/tokens url.
+	URLTargetForSCIMTokens = "config/auth/scim/tokens"
+	// /config/auth/scim/tokens/{tokenID} url.
+	URLTargetPatternForSCIMToken = "config/auth/scim/tokens/%s"
+)
+
+// SCIMSettings eNZi SCIM provisioning settings.
+type SCIMSettings struct {
+	Enabled bool `json:"enabled"`
+}
+
+// CreateSCIMToken struct.
+type CreateSCIMToken struct {
+	Description string `json:"description"`
+}
+
+// ResponseSCIMToken a SCIM bearer token, used by an IdP to provision users.
+type ResponseSCIMToken struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	CreatedAt   string `json:"createdAt"`
+	// Token is only returned when the token is created.
+	Token string `json:"token,omitempty"`
+}
+
+// ResponseSCIMTokens a list of SCIM tokens.
+type ResponseSCIMTokens struct {
+	Tokens []ResponseSCIMToken `json:"tokens"`
+}
+
+// ApiSCIMSettingsRead retrieve the SCIM settings of the cluster.
+func (c *Client) ApiSCIMSettingsRead(ctx context.Context) (SCIMSettings, error) {
+	req, err := c.RequestFromTargetAndBytesBody(ctx, http.MethodGet, URLTargetForSCIMSettings, []byte{})
+	if err != nil {
+		return SCIMSettings{}, fmt.Errorf("reading SCIM settings failed. %w: %s", ErrRequestCreation, err)
+	}
+
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return SCIMSettings{}, fmt.Errorf("reading SCIM settings failed. %w", err)
+	}
+
+	settings := SCIMSettings{}
+	if err := resp.JSONMarshallBody(&settings); err != nil {
+		return SCIMSettings{}, fmt.Errorf("reading SCIM settings failed. %w: %s", ErrUnmarshaling, err)
+	}
+	return settings, nil
+}
+
+// ApiSCIMSettingsUpdate replace the SCIM settings of the cluster.
+func (c *Client) ApiSCIMSettingsUpdate(ctx context.Context, settings SCIMSettings) (SCIMSettings, error) {
+	req, err := c.RequestFromTargetAndJSONBody(ctx, http.MethodPut, URLTargetForSCIMSettings, settings)
+	if err != nil {
+		return SCIMSettings{}, fmt.Errorf("updating SCIM settings failed. %w: %s", ErrRequestCreation, err)
+	}
+
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return SCIMSettings{}, fmt.Errorf("updating SCIM settings failed. %w", err)
+	}
+
+	resSettings := SCIMSettings{}
+	if err := resp.JSONMarshallBody(&resSettings); err != nil {
+		return SCIMSettings{}, fmt.Errorf("updating SCIM settings failed. %w: %s", ErrUnmarshaling, err)
+	}
+	return resSettings, nil
+}
+
+// ApiSCIMTokenCreate generate a new SCIM token. This is the only time that the token value is returned.
+func (c *Client) ApiSCIMTokenCreate(ctx context.Context, token CreateSCIMToken) (ResponseSCIMToken, error) {
+	req, err := c.RequestFromTargetAndJSONBody(ctx, http.MethodPost, URLTargetForSCIMTokens, token)
+	if err != nil {
+		return ResponseSCIMToken{}, fmt.Errorf("creating SCIM token failed. %w: %s", ErrRequestCreation, err)
+	}
+
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return ResponseSCIMToken{}, fmt.Errorf("creating SCIM token failed. %w", err)
+	}
+
+	resToken := ResponseSCIMToken{}
+	if err := resp.JSONMarshallBody(&resToken); err != nil {
+		return ResponseSCIMToken{}, fmt.Errorf("creating SCIM token failed. %w: %s", ErrUnmarshaling, err)
+	}
+	return resToken, nil
+}
+
+// ApiSCIMTokenList list the SCIM tokens, without their token values.
+func (c *Client) ApiSCIMTokenList(ctx context.Context) ([]ResponseSCIMToken, error) {
+	req, err := c.RequestFromTargetAndBytesBody(ctx, http.MethodGet, URLTargetForSCIMTokens, []byte{})
+	if err != nil {
+		return nil, fmt.Errorf("listing SCIM tokens failed. %w: %s", ErrRequestCreation, err)
+	}
+
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("listing SCIM tokens failed. %w", err)
+	}
+
+	resTokens := ResponseSCIMTokens{}
+	if err := resp.JSONMarshallBody(&resTokens); err != nil {
+		return nil, fmt.Errorf("listing SCIM tokens failed. %w: %s", ErrUnmarshaling, err)
+	}
+	return resTokens.Tokens, nil
+}
+
+// ApiSCIMTokenRead retrieve a SCIM token by ID, without its token value.
+func (c *Client) ApiSCIMTokenRead(ctx context.Context, id string) (ResponseSCIMToken, error) {
+	url := fmt.Sprintf(URLTargetPatternForSCIMToken, id)
+
+	req, err := c.RequestFromTargetAndBytesBody(ctx, http.MethodGet, url, []byte{})
+	if err != nil {
+		return ResponseSCIMToken{}, fmt.Errorf("reading SCIM token %s failed. %w: %s", id, ErrRequestCreation, err)
+	}
+
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return ResponseSCIMToken{}, fmt.Errorf("reading SCIM token %s failed. %w", id, err)
+	}
+
+	resToken := ResponseSCIMToken{}
+	if err := resp.JSONMarshallBody(&resToken); err != nil {
+		return ResponseSCIMToken{}, fmt.Errorf("reading SCIM token %s failed. %w: %s", id, ErrUnmarshaling, err)
+	}
+	return resToken, nil
+}
+
+// ApiSCIMTokenDelete revoke a SCIM token.
+func (c *Client) ApiSCIMTokenDelete(ctx context.Context, id string) error {
+	url := fmt.Sprintf(URLTargetPatternForSCIMToken, id)
+
+	req, err := c.RequestFromTargetAndBytesBody(ctx, http.MethodDelete, url, []byte{})
+	if err != nil {
+		return fmt.Errorf("deleting SCIM token %s failed. %w: %s", id, ErrRequestCreation, err)
+	}
+
+	if _, err = c.doAuthorizedRequest(req); err != nil {
+		return fmt.Errorf("deleting SCIM token %s failed. %w", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+func TestSCIMSettings(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	settings := client.SCIMSettings{Enabled: true}
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodPut, client.URLTargetForSCIMSettings, MockServerHandlerGeneratorReturnJson(settings))
+	s.AddHandler(http.MethodGet, client.URLTargetForSCIMSettings, MockServerHandlerGeneratorReturnJson(settings))
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	if _, err := c.ApiSCIMSettingsUpdate(ctx, settings); err != nil {
+		t.Fatalf("update SCIM settings failed: %s", err)
+	}
+
+	read, err := c.ApiSCIMSettingsRead(ctx)
+	if err != nil {
+		t.Fatalf("read SCIM settings failed: %s", err)
+	}
+	if read != settings {
+		t.Errorf("expected (%+v), got (%+v)", settings, read)
+	}
+}
+
+func TestSCIMTokenLifecycle(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	created := client.ResponseSCIMToken{ID: "token-id", Description: "okta", CreatedAt: "2024-01-01T00:00:00Z", Token: "secret"}
+	listed := created
+	listed.Token = ""
+	tokenURL := fmt.Sprintf(client.URLTargetPatternForSCIMToken, created.ID)
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodPost, client.URLTargetForSCIMTokens, MockServerHandlerGeneratorReturnJson(created))
+	s.AddHandler(http.MethodGet, client.URLTargetForSCIMTokens, MockServerHandlerGeneratorReturnJson(client.ResponseSCIMTokens{Tokens: []client.ResponseSCIMToken{listed}}))
+	s.AddHandler(http.MethodGet, tokenURL, MockServerHandlerGeneratorReturnJson(listed))
+	s.AddHandler(http.MethodDelete, tokenURL, MockServerHandlerGeneratorReturnResponseStatus(http.StatusNoContent))
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	token, err := c.ApiSCIMTokenCreate(ctx, client.CreateSCIMToken{Description: "okta"})
+	if err != nil {
+		t.Fatalf("create SCIM token failed: %s", err)
+	}
+	if token != created {
+		t.Errorf("expected (%+v), got (%+v)", created, token)
+	}
+
+	tokens, err := c.ApiSCIMTokenList(ctx)
+	if err != nil {
+		t.Fatalf("list SCIM tokens failed: %s", err)
+	}
+	if len(tokens) != 1 || tokens[0] != listed {
+		t.Errorf("expected ([%+v]), got (%+v)", listed, tokens)
+	}
+
+	read, err := c.ApiSCIMTokenRead(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("read SCIM token failed: %s", err)
+	}
+	if read != listed {
+		t.Errorf("expected (%+v), got (%+v)", listed, read)
+	}
+
+	if err := c.ApiSCIMTokenDelete(ctx, created.ID); err != nil {
+		t.Errorf("delete SCIM token failed: %s", err)
+	}
+}
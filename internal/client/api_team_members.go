@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	// /accounts/{orgNameOrID}/teams/{teamNameOrID}/members url.
+	URLTargetPatternForTeamMembers = "accounts/%s/teams/%s/members"
+	// /accounts/{orgNameOrID}/teams/{teamNameOrID}/members/{memberNameOrID} url.
+	URLTargetPatternForTeamMember = "accounts/%s/teams/%s/members/%s"
+)
+
+// ApiTeamMemberList list all of the members of a team, following pagination.
+func (c *Client) ApiTeamMemberList(ctx context.Context, org, team string) ([]ResponseMember, error) {
+	u := fmt.Sprintf(URLTargetPatternForTeamMembers, org, team)
+
+	members, err := listAllPages(ctx, c, u, nil, func(p ResponseMembers) ([]ResponseMember, string) {
+		return p.Members, p.NextPageStart
+	})
+	if err != nil {
+		return members, fmt.Errorf("listing members of team %s/%s failed. %w", org, team, err)
+	}
+
+	return members, nil
+}
+
+// ApiTeamMemberRead retrieve the membership of a single account in a team.
+func (c *Client) ApiTeamMemberRead(ctx context.Context, org, team, member string) (ResponseMember, error) {
+	u := fmt.Sprintf(URLTargetPatternForTeamMember, org, team, member)
+
+	req, err := c.RequestFromTargetAndBytesBody(ctx, http.MethodGet, u, []byte{})
+	if err != nil {
+		return ResponseMember{}, fmt.Errorf("reading member %s of team %s/%s failed. %w: %s", member, org, team, ErrRequestCreation, err)
+	}
+
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return ResponseMember{}, fmt.Errorf("reading member %s of team %s/%s failed. %w", member, org, team, err)
+	}
+
+	resMember := ResponseMember{}
+	if err := resp.JSONMarshallBody(&resMember); err != nil {
+		return ResponseMember{}, fmt.Errorf("reading member %s of team %s/%s failed. %w: %s", member, org, team, ErrUnmarshaling, err)
+	}
+	return resMember, nil
+}
+
+// ApiTeamMemberSet add an account to a team, or update its team admin flag if it is already a member.
+func (c *Client) ApiTeamMemberSet(ctx context.Context, org, team, member string, isAdmin bool) (ResponseMember, error) {
+	u := fmt.Sprintf(URLTargetPatternForTeamMember, org, team, member)
+
+	req, err := c.RequestFromTargetAndJSONBody(ctx, http.MethodPut, u, MemberForm{IsAdmin: isAdmin})
+	if err != nil {
+		return ResponseMember{}, fmt.Errorf("setting member %s of team %s/%s failed. %w: %s", member, org, team, ErrRequestCreation, err)
+	}
+
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return ResponseMember{}, fmt.Errorf("setting member %s of team %s/%s failed. %w", member, org, team, err)
+	}
+
+	resMember := ResponseMember{}
+	if err := resp.JSONMarshallBody(&resMember); err != nil {
+		return ResponseMember{}, fmt.Errorf("setting member %s of team %s/%s failed. %w: %s", member, org, team, ErrUnmarshaling, err)
+	}
+	return resMember, nil
+}
+
+// ApiTeamMemberRemove remove an account from a team.
+func (c *Client) ApiTeamMemberRemove(ctx context.Context, org, team, member string) error {
+	u := fmt.Sprintf(URLTargetPatternForTeamMember, org, team, member)
+
+	req, err := c.RequestFromTargetAndBytesBody(ctx, http.MethodDelete, u, []byte{})
+	if err != nil {
+		return fmt.Errorf("removing member %s of team %s/%s failed. %w: %s", member, org, team, ErrRequestCreation, err)
+	}
+
+	if _, err = c.doAuthorizedRequest(req); err != nil {
+		return fmt.Errorf("removing member %s of team %s/%s failed. %w", member, org, team, err)
+	}
+	return nil
+}
+
+// ApiTeamMembersApply apply a members diff to a team, one member at a time.
+func (c *Client) ApiTeamMembersApply(ctx context.Context, org, team string, diff MembersDiff) error {
+	for _, name := range diff.Remove {
+		if err := c.ApiTeamMemberRemove(ctx, org, team, name); err != nil && !IsNotFound(err) {
+			return err
+		}
+	}
+	for _, name := range diff.SetNames() {
+		if _, err := c.ApiTeamMemberSet(ctx, org, team, name, diff.Set[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+func TestTeamMemberListPaginates(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	pages := map[string]client.ResponseMembers{
+		"":      {NextPageStart: "user2", Members: []client.ResponseMember{member("user1", true)}},
+		"user2": {Members: []client.ResponseMember{member("user2", false)}},
+	}
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodGet, fmt.Sprintf(client.URLTargetPatternForTeamMembers, "myorg", "myteam"), func(w http.ResponseWriter, r *http.Request) {
+		if authHeaders := r.Header.Values(client.HeaderKeyAuthorization); len(authHeaders) != 1 {
+			t.Errorf("expected one authorization header on every page, got %d", len(authHeaders))
+		}
+		MockServerHandlerGeneratorReturnJson(pages[r.URL.Query().Get(client.URLQueryPageStart)])(w, r)
+	})
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	members, err := c.ApiTeamMemberList(ctx, "myorg", "myteam")
+	if err != nil {
+		t.Fatalf("list team members failed: %s", err)
+	}
+	if len(members) != 2 || !members[0].IsAdmin || members[1].Member.Name != "user2" {
+		t.Errorf("expected both members across pages, got %+v", members)
+	}
+}
+
+func TestTeamMembersApplyOnlySendsChanges(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	puts := map[string]bool{}
+	deletes := []string{}
+
+	s := NewMockTestServer(&auth, t)
+	for _, name := range []string{"add", "promote"} {
+		name := name
+		s.AddHandler(http.MethodPut, fmt.Sprintf(client.URLTargetPatternForTeamMember, "myorg", "myteam", name), func(w http.ResponseWriter, r *http.Request) {
+			b, _ := io.ReadAll(r.Body)
+			var f client.MemberForm
+			if err := json.Unmarshal(b, &f); err != nil {
+				t.Errorf("member form was not json: %s", b)
+			}
+			puts[name] = f.IsAdmin
+			MockServerHandlerGeneratorReturnJson(member(name, f.IsAdmin))(w, r)
+		})
+	}
+	s.AddHandler(http.MethodDelete, fmt.Sprintf(client.URLTargetPatternForTeamMember, "myorg", "myteam", "drop"), func(w http.ResponseWriter, r *http.Request) {
+		deletes = append(deletes, "drop")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	current := []client.ResponseMember{member("keep", false), member("promote", false), member("drop", false)}
+	diff := client.DiffMembers(current, map[string]bool{"keep": false, "promote": true, "add": false})
+
+	if err := c.ApiTeamMembersApply(ctx, "myorg", "myteam", diff); err != nil {
+		t.Fatalf("applying member changes failed: %s", err)
+	}
+	if len(puts) != 2 || !puts["promote"] || puts["add"] {
+		t.Errorf("unexpected member updates: %+v", puts)
+	}
+	if len(deletes) != 1 {
+		t.Errorf("unexpected member removals: %+v", deletes)
+	}
+}
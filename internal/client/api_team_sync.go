@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const (
+	// /accounts/{orgNameOrID}/teams/{teamNameOrID}/memberSyncConfig url.
+	URLTargetPatternForTeamMemberSyncConfig = "accounts/%s/teams/%s/memberSyncConfig"
+)
+
+var (
+	ErrInvalidMemberSyncConfig = errors.New("invalid team member sync config")
+)
+
+// MemberSyncConfig LDAP sync configuration of a team.
+// Members are either selected directly from the members attribute of a group, or
+// by searching for users with a filter.
+type MemberSyncConfig struct {
+	EnableSync         bool   `json:"enableSync"`
+	SelectGroupMembers bool   `json:"selectGroupMembers"`
+	GroupDN            string `json:"groupDN"`
+	GroupMemberAttr    string `json:"groupMemberAttr"`
+	SearchBaseDN       string `json:"searchBaseDN"`
+	SearchScopeSubtree bool   `json:"searchScopeSubtree"`
+	SearchFilter       string `json:"searchFilter"`
+}
+
+// Validate check that the fields needed for the selected sync mode are set.
+// A config with sync disabled is always valid.
+func (msc MemberSyncConfig) Validate() error {
+	if !msc.EnableSync {
+		return nil
+	}
+
+	if msc.SelectGroupMembers {
+		if msc.GroupDN == "" || msc.GroupMemberAttr == "" {
+			return fmt.Errorf("%w: selecting group members needs a group DN and a group member attribute", ErrInvalidMemberSyncConfig)
+		}
+		return nil
+	}
+
+	if msc.SearchBaseDN == "" || msc.SearchFilter == "" {
+		return fmt.Errorf("%w: searching for members needs a search base DN and a search filter", ErrInvalidMemberSyncConfig)
+	}
+	return nil
+}
+
+// ApiTeamMemberSyncConfigRead retrieve the LDAP sync config of a team.
+func (c *Client) ApiTeamMemberSyncConfigRead(ctx context.Context, org, team string) (MemberSyncConfig, error) {
+	u := fmt.Sprintf(URLTargetPatternForTeamMemberSyncConfig, org, team)
+
+	req, err := c.RequestFromTargetAndBytesBody(ctx, http.MethodGet, u, []byte{})
+	if err != nil {
+		return MemberSyncConfig{}, fmt.Errorf("reading member sync config of team %s/%s failed. %w: %s", org, team, ErrRequestCreation, err)
+	}
+
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return MemberSyncConfig{}, fmt.Errorf("reading member sync config of team %s/%s failed. %w", org, team, err)
+	}
+
+	msc := MemberSyncConfig{}
+	if err := resp.JSONMarshallBody(&msc); err != nil {
+		return MemberSyncConfig{}, fmt.Errorf("reading member sync config of team %s/%s failed. %w: %s", org, team, ErrUnmarshaling, err)
+	}
+	return msc, nil
+}
+
+// ApiTeamMemberSyncConfigUpdate replace the LDAP sync config of a team.
+func (c *Client) ApiTeamMemberSyncConfigUpdate(ctx context.Context, org, team string, msc MemberSyncConfig) (MemberSyncConfig, error) {
+	if err := msc.Validate(); err != nil {
+		return MemberSyncConfig{}, fmt.Errorf("updating member sync config of team %s/%s failed. %w", org, team, err)
+	}
+
+	u := fmt.Sprintf(URLTargetPatternForTeamMemberSyncConfig, org, team)
+
+	req, err := c.RequestFromTargetAndJSONBody(ctx, http.MethodPut, u, msc)
+	if err != nil {
+		return MemberSyncConfig{}, fmt.Errorf("updating member sync config of team %s/%s failed. %w: %s", org, team, ErrRequestCreation, err)
+	}
+
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return MemberSyncConfig{}, fmt.Errorf("updating member sync config of team %s/%s failed. %w", org, team, err)
+	}
+
+	resMsc := MemberSyncConfig{}
+	if err := resp.JSONMarshallBody(&resMsc); err != nil {
+		return MemberSyncConfig{}, fmt.Errorf("updating member sync config of team %s/%s failed. %w: %s", org, team, ErrUnmarshaling, err)
+	}
+	return resMsc, nil
+}
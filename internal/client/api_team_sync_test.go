@@ -0,0 +1,73 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+func TestMemberSyncConfigValidate(t *testing.T) {
+	tests := map[string]struct {
+		msc   client.MemberSyncConfig
+		valid bool
+	}{
+		"disabled": {
+			msc:   client.MemberSyncConfig{},
+			valid: true,
+		},
+		"group members": {
+			msc:   client.MemberSyncConfig{EnableSync: true, SelectGroupMembers: true, GroupDN: "cn=devs,dc=example,dc=com", GroupMemberAttr: "member"},
+			valid: true,
+		},
+		"group members without attribute": {
+			msc: client.MemberSyncConfig{EnableSync: true, SelectGroupMembers: true, GroupDN: "cn=devs,dc=example,dc=com"},
+		},
+		"search": {
+			msc:   client.MemberSyncConfig{EnableSync: true, SearchBaseDN: "dc=example,dc=com", SearchFilter: "(memberOf=devs)"},
+			valid: true,
+		},
+		"search without filter": {
+			msc: client.MemberSyncConfig{EnableSync: true, SearchBaseDN: "dc=example,dc=com", GroupDN: "cn=devs,dc=example,dc=com"},
+		},
+	}
+
+	for name, test := range tests {
+		err := test.msc.Validate()
+		if test.valid && err != nil {
+			t.Errorf("%s: expected a valid config, got: %s", name, err)
+		}
+		if !test.valid && !errors.Is(err, client.ErrInvalidMemberSyncConfig) {
+			t.Errorf("%s: expected an invalid config error, got: %v", name, err)
+		}
+	}
+}
+
+func TestTeamMemberSyncConfigUpdate(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	msc := client.MemberSyncConfig{EnableSync: true, SearchBaseDN: "dc=example,dc=com", SearchFilter: "(memberOf=devs)"}
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodPut, fmt.Sprintf(client.URLTargetPatternForTeamMemberSyncConfig, "myorg", "myteam"), MockServerHandlerGeneratorReturnJson(msc))
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	resp, err := c.ApiTeamMemberSyncConfigUpdate(ctx, "myorg", "myteam", msc)
+	if err != nil {
+		t.Fatalf("update member sync config failed: %s", err)
+	}
+	if resp != msc {
+		t.Errorf("expected (%+v), got (%+v)", msc, resp)
+	}
+
+	// invalid configs are not sent
+	if _, err := c.ApiTeamMemberSyncConfigUpdate(ctx, "myorg", "myteam", client.MemberSyncConfig{EnableSync: true}); !errors.Is(err, client.ErrInvalidMemberSyncConfig) {
+		t.Errorf("expected an invalid config error, got: %v", err)
+	}
+}
@@ -0,0 +1,140 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	// /accounts/{orgNameOrID}/teams url.
+	URLTargetPatternForTeams = "accounts/%s/teams"
+	// /accounts/{orgNameOrID}/teams/{teamNameOrID} url.
+	URLTargetPatternForTeam = "accounts/%s/teams/%s"
+
+	// URLQueryPageStart query key used to request the next page of an eNZi listing.
+	URLQueryPageStart = "start"
+)
+
+// CreateTeam struct.
+type CreateTeam struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// UpdateTeam struct.
+type UpdateTeam struct {
+	Description string `json:"description"`
+}
+
+// ResponseTeam struct.
+type ResponseTeam struct {
+	OrgID        string `json:"orgID"`
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	MembersCount int    `json:"membersCount"`
+}
+
+// ResponseTeams struct.
+type ResponseTeams struct {
+	NextPageStart string         `json:"nextPageStart"`
+	Teams         []ResponseTeam `json:"teams"`
+}
+
+// ApiTeamCreate create a team in an organization.
+func (c *Client) ApiTeamCreate(ctx context.Context, org string, team CreateTeam) (ResponseTeam, error) {
+	if team.Name == "" {
+		return ResponseTeam{}, fmt.Errorf("creating team in org %s failed. %w: %+v", org, ErrEmptyStruct, team)
+	}
+
+	u := fmt.Sprintf(URLTargetPatternForTeams, org)
+
+	req, err := c.RequestFromTargetAndJSONBody(ctx, http.MethodPost, u, team)
+	if err != nil {
+		return ResponseTeam{}, fmt.Errorf("creating team %s/%s failed. %w: %s", org, team.Name, ErrRequestCreation, err)
+	}
+
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return ResponseTeam{}, fmt.Errorf("creating team %s/%s failed. %w", org, team.Name, err)
+	}
+
+	resTeam := ResponseTeam{}
+	if err := resp.JSONMarshallBody(&resTeam); err != nil {
+		return ResponseTeam{}, fmt.Errorf("creating team %s/%s failed. %w: %s", org, team.Name, ErrUnmarshaling, err)
+	}
+
+	return resTeam, nil
+}
+
+// ApiTeamRead retrieve a team from an organization by name or ID.
+func (c *Client) ApiTeamRead(ctx context.Context, org, team string) (ResponseTeam, error) {
+	u := fmt.Sprintf(URLTargetPatternForTeam, org, team)
+
+	req, err := c.RequestFromTargetAndBytesBody(ctx, http.MethodGet, u, []byte{})
+	if err != nil {
+		return ResponseTeam{}, fmt.Errorf("reading team %s/%s failed. %w: %s", org, team, ErrRequestCreation, err)
+	}
+
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return ResponseTeam{}, fmt.Errorf("reading team %s/%s failed. %w", org, team, err)
+	}
+
+	resTeam := ResponseTeam{}
+	if err := resp.JSONMarshallBody(&resTeam); err != nil {
+		return ResponseTeam{}, fmt.Errorf("reading team %s/%s failed. %w: %s", org, team, ErrUnmarshaling, err)
+	}
+	return resTeam, nil
+}
+
+// ApiTeamUpdate update a team in an organization.
+func (c *Client) ApiTeamUpdate(ctx context.Context, org, team string, update UpdateTeam) (ResponseTeam, error) {
+	u := fmt.Sprintf(URLTargetPatternForTeam, org, team)
+
+	req, err := c.RequestFromTargetAndJSONBody(ctx, http.MethodPatch, u, update)
+	if err != nil {
+		return ResponseTeam{}, fmt.Errorf("updating team %s/%s failed. %w: %s", org, team, ErrRequestCreation, err)
+	}
+
+	resp, err := c.doAuthorizedRequest(req)
+	if err != nil {
+		return ResponseTeam{}, fmt.Errorf("updating team %s/%s failed. %w", org, team, err)
+	}
+
+	resTeam := ResponseTeam{}
+	if err := resp.JSONMarshallBody(&resTeam); err != nil {
+		return ResponseTeam{}, fmt.Errorf("updating team %s/%s failed. %w: %s", org, team, ErrUnmarshaling, err)
+	}
+	return resTeam, nil
+}
+
+// ApiTeamDelete delete a team from an organization.
+func (c *Client) ApiTeamDelete(ctx context.Context, org, team string) error {
+	u := fmt.Sprintf(URLTargetPatternForTeam, org, team)
+
+	req, err := c.RequestFromTargetAndBytesBody(ctx, http.MethodDelete, u, []byte{})
+	if err != nil {
+		return fmt.Errorf("deleting team %s/%s failed. %w: %s", org, team, ErrRequestCreation, err)
+	}
+
+	if _, err = c.doAuthorizedRequest(req); err != nil {
+		return fmt.Errorf("deleting team %s/%s failed. %w", org, team, err)
+	}
+	return nil
+}
+
+// ApiTeamList list all of the teams in an organization, following pagination.
+func (c *Client) ApiTeamList(ctx context.Context, org string) ([]ResponseTeam, error) {
+	u := fmt.Sprintf(URLTargetPatternForTeams, org)
+
+	teams, err := listAllPages(ctx, c, u, nil, func(p ResponseTeams) ([]ResponseTeam, string) {
+		return p.Teams, p.NextPageStart
+	})
+	if err != nil {
+		return teams, fmt.Errorf("listing teams of org %s failed. %w", org, err)
+	}
+
+	return teams, nil
+}
@@ -0,0 +1,105 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+func TestTeamCRUD(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	org := "myorg"
+	expectedTeam := client.ResponseTeam{OrgID: "org-id", ID: "team-id", Name: "myteam", Description: "my team"}
+	teamURL := fmt.Sprintf(client.URLTargetPatternForTeam, org, expectedTeam.Name)
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodPost, fmt.Sprintf(client.URLTargetPatternForTeams, org), MockServerHandlerGeneratorReturnJson(expectedTeam))
+	s.AddHandler(http.MethodGet, teamURL, MockServerHandlerGeneratorReturnJson(expectedTeam))
+	s.AddHandler(http.MethodPatch, teamURL, MockServerHandlerGeneratorReturnJson(expectedTeam))
+	s.AddHandler(http.MethodDelete, teamURL, MockServerHandlerGeneratorReturnResponseStatus(http.StatusNoContent))
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	if resp, err := c.ApiTeamCreate(ctx, org, client.CreateTeam{Name: "myteam", Description: "my team"}); err != nil {
+		t.Errorf("create team failed: %s", err)
+	} else if resp != expectedTeam {
+		t.Errorf("expected (%+v), got (%+v)", expectedTeam, resp)
+	}
+
+	if resp, err := c.ApiTeamRead(ctx, org, "myteam"); err != nil {
+		t.Errorf("read team failed: %s", err)
+	} else if resp != expectedTeam {
+		t.Errorf("expected (%+v), got (%+v)", expectedTeam, resp)
+	}
+
+	if _, err := c.ApiTeamUpdate(ctx, org, "myteam", client.UpdateTeam{Description: "my team"}); err != nil {
+		t.Errorf("update team failed: %s", err)
+	}
+
+	if err := c.ApiTeamDelete(ctx, org, "myteam"); err != nil {
+		t.Errorf("delete team failed: %s", err)
+	}
+}
+
+func TestTeamCreateEmptyName(t *testing.T) {
+	c := client.Client{}
+
+	if _, err := c.ApiTeamCreate(context.Background(), "myorg", client.CreateTeam{}); err == nil {
+		t.Error("expected an error for a team without a name")
+	}
+}
+
+func TestTeamReadNotFound(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodGet, fmt.Sprintf(client.URLTargetPatternForTeam, "myorg", "missing"), MockServerHandlerGeneratorReturnResponseStatus(http.StatusNotFound))
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	if _, err := c.ApiTeamRead(ctx, "myorg", "missing"); !client.IsNotFound(err) {
+		t.Errorf("expected a not found error, got: %v", err)
+	}
+}
+
+func TestTeamListPaginates(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	org := "myorg"
+	pages := map[string]client.ResponseTeams{
+		"":      {NextPageStart: "team2", Teams: []client.ResponseTeam{{Name: "team1"}}},
+		"team2": {Teams: []client.ResponseTeam{{Name: "team2"}, {Name: "team3"}}},
+	}
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodGet, fmt.Sprintf(client.URLTargetPatternForTeams, org), func(w http.ResponseWriter, r *http.Request) {
+		if authHeaders := r.Header.Values(client.HeaderKeyAuthorization); len(authHeaders) != 1 {
+			t.Errorf("expected one authorization header on every page, got %d", len(authHeaders))
+		}
+		page, ok := pages[r.URL.Query().Get(client.URLQueryPageStart)]
+		if !ok {
+			t.Errorf("unexpected page requested: %s", r.URL.RawQuery)
+		}
+		MockServerHandlerGeneratorReturnJson(page)(w, r)
+	})
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	teams, err := c.ApiTeamList(ctx, org)
+	if err != nil {
+		t.Fatalf("list teams failed: %s", err)
+	}
+	if len(teams) != 3 || teams[2].Name != "team3" {
+		t.Errorf("expected all three teams across pages, got %+v", teams)
+	}
+}
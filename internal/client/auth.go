@@ -49,7 +49,7 @@ func (c *Client) authorizeRequest(req *http.Request) error {
 		}
 	}
 
-	req.Header.Add(HeaderKeyAuthorization, BearerTokenHeaderValue(c.auth.Token))
+	req.Header.Set(HeaderKeyAuthorization, BearerTokenHeaderValue(c.auth.Token))
 
 	return nil
 }
@@ -0,0 +1,120 @@
+package cassette
+
+/**
+
+# HTTP cassettes
+
+A cassette is a recording of the http interactions between the MKE client and
+an MKE server, scrubbed of any secrets. A Recorder plugs into the client's
+http.Client, and either records the traffic or replays it.
+
+Cassettes recorded against a live cluster (see the integration tests in the
+client package) are kept per MKE version:
+
+	testdata/cassettes/{mke version}/{scenario}.json
+
+No cassettes are committed yet, so the client tests only replay traffic which
+they record themselves.
+
+*/
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// BodyEncodingBase64 marks a body which was not valid UTF-8 text, such as a client bundle zip.
+	BodyEncodingBase64 = "base64"
+)
+
+var (
+	ErrCassetteLoad     = errors.New("could not load http cassette")
+	ErrCassetteSave     = errors.New("could not save http cassette")
+	ErrNoInteraction    = errors.New("no recorded interaction matches the request")
+	ErrUnsupportedMode  = errors.New("unsupported cassette recorder mode")
+	ErrRecorderStopped  = errors.New("cassette recorder has been stopped")
+	ErrBodyNotDecodable = errors.New("recorded body could not be decoded")
+)
+
+// Cassette a named list of recorded interactions for an MKE version.
+type Cassette struct {
+	Name         string        `json:"name"`
+	MKEVersion   string        `json:"mke_version,omitempty"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction a single recorded request/response pair.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request the parts of a recorded http request which are used for matching.
+type Request struct {
+	Method  string      `json:"method"`
+	Path    string      `json:"path"`
+	Query   string      `json:"query,omitempty"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+// Response a recorded http response.
+type Response struct {
+	StatusCode   int         `json:"status_code"`
+	Headers      http.Header `json:"headers,omitempty"`
+	Body         string      `json:"body,omitempty"`
+	BodyEncoding string      `json:"body_encoding,omitempty"`
+}
+
+// Load a cassette from a json file.
+func Load(path string) (*Cassette, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w; %s", ErrCassetteLoad, err)
+	}
+
+	var c Cassette
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, fmt.Errorf("%w; %s: %s", ErrCassetteLoad, path, err)
+	}
+
+	return &c, nil
+}
+
+// Save the cassette as an indented json file, creating any missing directories.
+func (c *Cassette) Save(path string) error {
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("%w; %s", ErrCassetteSave, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("%w; %s", ErrCassetteSave, err)
+	}
+
+	if err := os.WriteFile(path, append(b, '\n'), 0o644); err != nil { //nolint:gosec
+		return fmt.Errorf("%w; %s", ErrCassetteSave, err)
+	}
+
+	return nil
+}
+
+// Match find the first interaction not marked as used which matches the request method, path and query.
+// Returns -1 if nothing matches.
+func (c *Cassette) Match(req Request, used []bool) int {
+	for i, in := range c.Interactions {
+		if i < len(used) && used[i] {
+			continue
+		}
+		if in.Request.Method == req.Method && in.Request.Path == req.Path && in.Request.Query == req.Query {
+			return i
+		}
+	}
+
+	return -1
+}
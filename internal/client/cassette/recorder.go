@@ -0,0 +1,278 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"unicode/utf8"
+)
+
+// Mode is the recorder either recording live traffic, or replaying a cassette.
+type Mode int
+
+const (
+	// ModeReplay serve responses from the cassette, never reaching the network.
+	ModeReplay Mode = iota
+	// ModeRecord pass requests through to the real transport, and record scrubbed interactions.
+	ModeRecord
+)
+
+// Recorder an http.RoundTripper which records or replays a cassette.
+//
+//	rec, _ := cassette.New("testdata/cassettes/3.7.5/ping.json", cassette.ModeReplay, nil)
+//	c, _ := client.NewClient(u, &auth, rec.HTTPClient())
+//	... use the client ...
+//	rec.Stop() // writes the cassette in record mode
+type Recorder struct {
+	mode      Mode
+	path      string
+	transport http.RoundTripper
+	scrubbers []Scrubber
+
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+	stopped  bool
+}
+
+// New Recorder constructor.  In replay mode the cassette at path is loaded. In record mode
+// the transport is used for the real requests (http.DefaultTransport if nil).
+func New(path string, mode Mode, transport http.RoundTripper) (*Recorder, error) {
+	r := &Recorder{
+		mode:      mode,
+		path:      path,
+		transport: transport,
+		scrubbers: DefaultScrubbers(),
+	}
+
+	switch mode {
+	case ModeReplay:
+		c, err := Load(path)
+		if err != nil {
+			return nil, err
+		}
+		r.cassette = c
+		r.used = make([]bool, len(c.Interactions))
+	case ModeRecord:
+		if r.transport == nil {
+			r.transport = http.DefaultTransport
+		}
+		r.cassette = &Cassette{}
+	default:
+		return nil, fmt.Errorf("%w; %d", ErrUnsupportedMode, mode)
+	}
+
+	return r, nil
+}
+
+// NewReplayServer serve a loaded cassette from an httptest.Server, so that code which builds its own
+// http client (such as the provider) can be pointed at recorded traffic as an endpoint.
+func NewReplayServer(c *Cassette) *httptest.Server {
+	r := &Recorder{
+		mode:     ModeReplay,
+		cassette: c,
+		used:     make([]bool, len(c.Interactions)),
+	}
+
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		res, err := r.RoundTrip(req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotImplemented)
+			return
+		}
+		defer res.Body.Close()
+
+		for k, vs := range res.Header {
+			for _, v := range vs {
+				w.Header().Add(k, v)
+			}
+		}
+		w.WriteHeader(res.StatusCode)
+		io.Copy(w, res.Body) //nolint:errcheck
+	}))
+}
+
+// Cassette the cassette being recorded or replayed.
+func (r *Recorder) Cassette() *Cassette {
+	return r.cassette
+}
+
+// Mode the recorder mode.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// AddScrubber add a scrubber which is run on each recorded interaction, after the default scrubbers.
+func (r *Recorder) AddScrubber(s Scrubber) {
+	r.scrubbers = append(r.scrubbers, s)
+}
+
+// HTTPClient an http.Client which uses the recorder as its transport, for client.Client.HTTPClient.
+func (r *Recorder) HTTPClient() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Stop the recorder, and in record mode save the cassette to its path.
+func (r *Recorder) Stop() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.stopped = true
+
+	if r.mode == ModeRecord {
+		return r.cassette.Save(r.path)
+	}
+	return nil
+}
+
+// RoundTrip implement http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.mu.Lock()
+	stopped := r.stopped
+	r.mu.Unlock()
+
+	if stopped {
+		return nil, ErrRecorderStopped
+	}
+
+	if r.mode == ModeRecord {
+		return r.record(req)
+	}
+	return r.replay(req)
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+
+	in := Interaction{
+		Request: Request{
+			Method:  req.Method,
+			Path:    req.URL.Path,
+			Query:   req.URL.Query().Encode(),
+			Headers: req.Header.Clone(),
+			Body:    string(reqBody),
+		},
+		Response: Response{
+			StatusCode: res.StatusCode,
+			Headers:    res.Header.Clone(),
+		},
+	}
+	in.Response.setBody(resBody)
+
+	for _, s := range r.scrubbers {
+		s(&in)
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, in)
+	r.mu.Unlock()
+
+	return res, nil
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	if _, err := readRequestBody(req); err != nil {
+		return nil, err
+	}
+
+	match := Request{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.Query().Encode(),
+	}
+
+	r.mu.Lock()
+	i := r.cassette.Match(match, r.used)
+	if i >= 0 {
+		r.used[i] = true
+	}
+	r.mu.Unlock()
+
+	if i < 0 {
+		return nil, fmt.Errorf("%w: %s %s?%s in cassette %s", ErrNoInteraction, match.Method, match.Path, match.Query, r.cassette.Name)
+	}
+
+	recorded := r.cassette.Interactions[i].Response
+
+	body, err := recorded.BodyBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	header := recorded.Headers.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Set("Content-Length", strconv.Itoa(len(body)))
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// BodyBytes the decoded response body.
+func (r Response) BodyBytes() ([]byte, error) {
+	if r.BodyEncoding == BodyEncodingBase64 {
+		b, err := base64.StdEncoding.DecodeString(r.Body)
+		if err != nil {
+			return nil, fmt.Errorf("%w; %s", ErrBodyNotDecodable, err)
+		}
+		return b, nil
+	}
+	return []byte(r.Body), nil
+}
+
+// setBody store a response body, base64 encoding it if it is not text.
+func (r *Response) setBody(b []byte) {
+	if utf8.Valid(b) {
+		r.Body = string(b)
+		r.BodyEncoding = ""
+		return
+	}
+	r.Body = base64.StdEncoding.EncodeToString(b)
+	r.BodyEncoding = BodyEncodingBase64
+}
+
+// readRequestBody read the request body, leaving a fresh copy on the request.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+
+	b, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(b))
+
+	return b, nil
+}
@@ -0,0 +1,205 @@
+package cassette
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"regexp"
+	"strings"
+)
+
+const (
+	// Redacted replacement value for scrubbed secrets.
+	Redacted = "REDACTED"
+)
+
+var (
+	// SecretJSONKeys json object keys whose values are always scrubbed (compared case-insensitively).
+	SecretJSONKeys = []string{
+		"password",
+		"oldPassword",
+		"newPassword",
+		"bindPassword",
+		"token",
+		"auth_token",
+		"code",
+	}
+
+	// SecretHeaders http headers whose values are always scrubbed.
+	SecretHeaders = []string{
+		"Authorization",
+		"Cookie",
+		"Set-Cookie",
+	}
+
+	privateKeyPEMRegex  = regexp.MustCompile(`-----BEGIN ([A-Z ]*)PRIVATE KEY-----[\s\S]*?-----END ([A-Z ]*)PRIVATE KEY-----`)
+	kubeClientKeyRegex  = regexp.MustCompile(`(client-key-data:\s*)\S+`)
+	kubeTokenRegex      = regexp.MustCompile(`(\stoken:\s*)\S+`)
+	redactedKubeKeyData = base64.StdEncoding.EncodeToString([]byte(Redacted))
+)
+
+// Scrubber modify a recorded interaction to remove secrets before it is saved.
+type Scrubber func(in *Interaction)
+
+// DefaultScrubbers scrubbers for auth headers, json passwords/tokens and private keys, including
+// the private keys inside client bundle zips.
+func DefaultScrubbers() []Scrubber {
+	return []Scrubber{
+		ScrubHeaders,
+		ScrubJSONBodies,
+		ScrubPrivateKeys,
+	}
+}
+
+// ScrubHeaders redact any secret headers in the request and response.
+func ScrubHeaders(in *Interaction) {
+	for _, h := range SecretHeaders {
+		if in.Request.Headers.Get(h) != "" {
+			in.Request.Headers.Set(h, Redacted)
+		}
+		if in.Response.Headers.Get(h) != "" {
+			in.Response.Headers.Set(h, Redacted)
+		}
+	}
+}
+
+// ScrubJSONBodies redact secret values in json request and response bodies.
+func ScrubJSONBodies(in *Interaction) {
+	in.Request.Body = scrubJSON(in.Request.Body)
+
+	if in.Response.BodyEncoding == "" {
+		in.Response.Body = scrubJSON(in.Response.Body)
+	}
+}
+
+// ScrubPrivateKeys redact PEM private keys and kubeconfig client keys in text bodies and zip archives.
+func ScrubPrivateKeys(in *Interaction) {
+	in.Request.Body = scrubPrivateKeyText(in.Request.Body)
+
+	if in.Response.BodyEncoding == "" {
+		in.Response.Body = scrubPrivateKeyText(in.Response.Body)
+		return
+	}
+
+	b, err := in.Response.BodyBytes()
+	if err != nil {
+		return
+	}
+	if sb, ok := scrubZip(b); ok {
+		in.Response.setBody(sb)
+	}
+}
+
+func scrubPrivateKeyText(s string) string {
+	s = privateKeyPEMRegex.ReplaceAllString(s, "-----BEGIN ${1}PRIVATE KEY-----\n"+Redacted+"\n-----END ${2}PRIVATE KEY-----")
+	s = kubeClientKeyRegex.ReplaceAllString(s, "${1}"+redactedKubeKeyData)
+	s = kubeTokenRegex.ReplaceAllString(s, "${1}"+Redacted)
+	return s
+}
+
+// scrubZip rewrite a zip archive with scrubbed text files, recursing into nested zips.
+// The archive comment is kept, as MKE uses it for the client bundle ID.
+func scrubZip(b []byte) ([]byte, bool) {
+	zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		return nil, false
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	for _, f := range zr.File {
+		fr, err := f.Open()
+		if err != nil {
+			return nil, false
+		}
+		content, err := io.ReadAll(fr)
+		fr.Close()
+		if err != nil {
+			return nil, false
+		}
+
+		if nested, ok := scrubZip(content); ok {
+			content = nested
+		} else if !bytes.ContainsRune(content, 0) {
+			content = []byte(scrubPrivateKeyText(string(content)))
+		}
+
+		hdr := f.FileHeader
+		w, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     hdr.Name,
+			Comment:  hdr.Comment,
+			Method:   hdr.Method,
+			Modified: hdr.Modified,
+		})
+		if err != nil {
+			return nil, false
+		}
+		if _, err := w.Write(content); err != nil {
+			return nil, false
+		}
+	}
+
+	if err := zw.SetComment(zr.Comment); err != nil {
+		return nil, false
+	}
+	if err := zw.Close(); err != nil {
+		return nil, false
+	}
+
+	return buf.Bytes(), true
+}
+
+// scrubJSON redact secret keys in a json document, returning non-json strings untouched.
+func scrubJSON(s string) string {
+	if strings.TrimSpace(s) == "" {
+		return s
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal([]byte(s), &doc); err != nil {
+		return s
+	}
+
+	doc = scrubJSONValue(doc)
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return s
+	}
+	return string(b)
+}
+
+func scrubJSONValue(v interface{}) interface{} {
+	switch tv := v.(type) {
+	case map[string]interface{}:
+		for k, kv := range tv {
+			if isSecretJSONKey(k) {
+				if str, ok := kv.(string); ok && str != "" {
+					tv[k] = Redacted
+				}
+				continue
+			}
+			tv[k] = scrubJSONValue(kv)
+		}
+	case []interface{}:
+		for i := range tv {
+			tv[i] = scrubJSONValue(tv[i])
+		}
+	case string:
+		return scrubPrivateKeyText(tv)
+	}
+
+	return v
+}
+
+func isSecretJSONKey(k string) bool {
+	for _, sk := range SecretJSONKeys {
+		if strings.EqualFold(sk, k) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,53 @@
+//go:build integration
+// +build integration
+
+package client_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client/cassette"
+)
+
+const (
+	// ENVCassetteVersion set to the MKE version of the integration cluster to record cassettes.
+	ENVCassetteVersion = "MKE_CASSETTE_VERSION"
+)
+
+// TestRecordCassettes record every scenario against the integration cluster.
+//
+//	MKE_CASSETTE_VERSION=3.7.5 go test -tags integration -run TestRecordCassettes ./internal/client/
+func TestRecordCassettes(t *testing.T) {
+	version := os.Getenv(ENVCassetteVersion)
+	if version == "" {
+		t.Skipf("%s not set, not recording cassettes", ENVCassetteVersion)
+	}
+
+	for name, scenario := range cassetteScenarios {
+		name, scenario := name, scenario
+		t.Run(name, func(t *testing.T) {
+			c, err := MakeIntegrationClient()
+			if err != nil {
+				t.Fatalf("Failed generating test client: %s", err)
+			}
+
+			rec, err := cassette.New(cassettePath(version, name), cassette.ModeRecord, c.HTTPClient.Transport)
+			if err != nil {
+				t.Fatalf("could not create recorder: %s", err)
+			}
+			rec.Cassette().Name = name
+			rec.Cassette().MKEVersion = version
+			c.HTTPClient = rec.HTTPClient()
+
+			if err := scenario(context.Background(), c); err != nil {
+				t.Errorf("scenario failed against the integration cluster: %s", err)
+			}
+
+			if err := rec.Stop(); err != nil {
+				t.Fatalf("could not save cassette: %s", err)
+			}
+		})
+	}
+}
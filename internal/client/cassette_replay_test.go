@@ -0,0 +1,85 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/Mirantis/terraform-provider-mke/internal/client/cassette"
+)
+
+// TestCassetteReplay replay every committed cassette through its scenario.
+func TestCassetteReplay(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join(cassetteDir, "*", "*.json"))
+	if err != nil {
+		t.Fatalf("could not search for cassettes: %s", err)
+	}
+	if len(paths) == 0 {
+		t.Skipf("no cassettes have been recorded in %s", cassetteDir)
+	}
+
+	for _, path := range paths {
+		path := path
+		t.Run(path, func(t *testing.T) {
+			scenario, ok := cassetteScenarios[cassetteScenarioFromPath(path)]
+			if !ok {
+				t.Fatalf("cassette has no matching scenario: %s", path)
+			}
+
+			c, _, err := cassetteReplayClient(path)
+			if err != nil {
+				t.Fatalf("could not create replay client: %s", err)
+			}
+
+			if err := scenario(context.Background(), c); err != nil {
+				t.Errorf("scenario failed against recorded traffic: %s", err)
+			}
+		})
+	}
+}
+
+// TestCassetteRecordMockServer record a scenario against the mock server, and replay it with a new client.
+func TestCassetteRecordMockServer(t *testing.T) {
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	accs := client.ResponseAccounts{
+		Accounts: []client.ResponseAccount{{Name: "mock1", ID: "1"}},
+	}
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodGet, client.URLTargetForAccounts, MockServerHandlerGeneratorReturnJson(accs))
+	s.AddHandler(http.MethodGet, client.URLTargetForAccounts+"/mock1", MockServerHandlerGeneratorReturnJson(accs.Accounts[0]))
+	defer s.Close()
+
+	// use an auth without a token, so that the login is recorded too
+	clientAuth := client.NewAuthUP(auth.Username, auth.Password)
+	u, _ := url.Parse(s.testServer.URL)
+	c, _ := client.NewClient(u, &clientAuth, s.testServer.Client())
+
+	path := filepath.Join(t.TempDir(), "accounts.json")
+
+	rec, err := cassette.New(path, cassette.ModeRecord, c.HTTPClient.Transport)
+	if err != nil {
+		t.Fatalf("could not create recorder: %s", err)
+	}
+	c.HTTPClient = rec.HTTPClient()
+
+	if err := cassetteScenarios["accounts"](ctx, &c); err != nil {
+		t.Fatalf("scenario failed against the mock server: %s", err)
+	}
+	if err := rec.Stop(); err != nil {
+		t.Fatalf("could not save cassette: %s", err)
+	}
+
+	rc, _, err := cassetteReplayClient(path)
+	if err != nil {
+		t.Fatalf("could not create replay client: %s", err)
+	}
+	if err := cassetteScenarios["accounts"](ctx, rc); err != nil {
+		t.Errorf("scenario failed against the recorded cassette: %s", err)
+	}
+}
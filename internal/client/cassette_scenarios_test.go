@@ -0,0 +1,96 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/Mirantis/terraform-provider-mke/internal/client/cassette"
+)
+
+/**
+Cassette scenarios are client call sequences which can be recorded against real MKE
+clusters (see cassette_integration_test.go). Each scenario is recorded to
+testdata/cassettes/{mke version}/{scenario}.json
+
+TestCassetteReplay replays any committed cassettes, and skips while there are none.
+*/
+
+const (
+	cassetteDir = "testdata/cassettes"
+
+	// cassetteReplayEndpoint endpoint used for replay clients, it is never contacted.
+	cassetteReplayEndpoint = "https://mke.cassette.invalid"
+)
+
+// cassetteScenario a recorded sequence of client calls.
+type cassetteScenario func(ctx context.Context, c *client.Client) error
+
+var cassetteScenarios = map[string]cassetteScenario{
+	"ping": func(ctx context.Context, c *client.Client) error {
+		return c.ApiPing(ctx)
+	},
+	"accounts": func(ctx context.Context, c *client.Client) error {
+		accs, err := c.ApiReadAccounts(ctx, client.AccountFilterUsers)
+		if err != nil {
+			return err
+		}
+		for _, acc := range accs {
+			if _, err := c.ApiReadAccount(ctx, acc.Name); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+	"clientbundle": func(ctx context.Context, c *client.Client) error {
+		cb, err := c.ApiClientBundleCreate(ctx, "cassette")
+		if err != nil {
+			return err
+		}
+		if _, err := c.ApiClientBundleGetPublicKey(ctx, cb); err != nil {
+			return err
+		}
+		return c.ApiClientBundleDelete(ctx, cb)
+	},
+}
+
+// cassettePath path to the cassette file for an MKE version and scenario.
+func cassettePath(version, scenario string) string {
+	return filepath.Join(cassetteDir, version, scenario+".json")
+}
+
+// cassetteScenarioFromPath scenario name from a cassette file path.
+func cassetteScenarioFromPath(path string) string {
+	return strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+}
+
+// cassetteReplayClient an MKE client which replays a cassette.
+func cassetteReplayClient(path string) (*client.Client, *cassette.Recorder, error) {
+	rec, err := cassette.New(path, cassette.ModeReplay, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// use the recorded username, as it is part of some API targets
+	username := commonTestAuth.Username
+	for _, in := range rec.Cassette().Interactions {
+		var recordedAuth client.Auth
+		if in.Request.Path == "/"+client.URLTargetForAuth && json.Unmarshal([]byte(in.Request.Body), &recordedAuth) == nil {
+			username = recordedAuth.Username
+			break
+		}
+	}
+
+	u, _ := url.Parse(cassetteReplayEndpoint)
+	auth := client.NewAuthUP(username, cassette.Redacted)
+
+	c, err := client.NewClient(u, &auth, rec.HTTPClient())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &c, rec, nil
+}
@@ -4,8 +4,6 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"io"
-
-	"gopkg.in/yaml.v2"
 )
 
 const (
@@ -40,6 +38,12 @@ type ClientBundleKube struct {
 	ClientCertificate string `json:"client_certificate"`
 	CACertificate     string `json:"cluster_ca_certificate"`
 	Insecure          string `json:"insecure"`
+
+	// the kubeconfig entries that the values above came from.
+	Namespace   string `json:"namespace"`
+	ContextName string `json:"context_name"`
+	ClusterName string `json:"cluster_name"`
+	UserName    string `json:"user_name"`
 }
 
 // ClientBundleMeta in the client bundle is a flattenned meta.json file.  It is buried kind of deep.
@@ -73,76 +77,25 @@ func ClientBundleDecodeBase64Value(val io.Reader) (string, error) {
 }
 
 // NewClientBundleKubeFromKubeYml ClientBundleKube constructor from byte list of a kubeconfig file.
+// The values are taken from the current context, or the first context if the current context does not exist.
+// A kubeconfig without contexts gives empty values.
 func NewClientBundleKubeFromKubeYml(val io.Reader) (ClientBundleKube, error) {
 	k8bytes, _ := io.ReadAll(val)
 
-	var cbk ClientBundleKube
-
-	// Struct representation of a kube config file.
-	// see https://zhwt.github.io/yaml-to-go/
-	var cbkHolder struct {
-		APIVersion  string            `yaml:"apiVersion"`
-		Kind        string            `yaml:"kind"`
-		Preferences map[string]string `yaml:"preferences"`
-		Clusters    []struct {
-			Name    string `yaml:"name"`
-			Cluster struct {
-				CertificateAuthorityData string `yaml:"certificate-authority-data"`
-				Server                   string `yaml:"server"`
-			} `yaml:"cluster"`
-		} `yaml:"clusters"`
-		Contexts []struct {
-			Name    string `yaml:"name"`
-			Context struct {
-				Cluster string `yaml:"cluster"`
-				User    string `yaml:"user"`
-			} `yaml:"context"`
-		} `yaml:"contexts"`
-		CurrentContext string `yaml:"current-context"`
-		Users          []struct {
-			Name string `yaml:"name"`
-			User struct {
-				ClientCertificateData string `yaml:"client-certificate-data"`
-				ClientKeyData         string `yaml:"client-key-data"`
-			} `yaml:"user"`
-		} `yaml:"users"`
-	}
-
-	cbk.Config = string(k8bytes)
-
-	if err := yaml.UnmarshalStrict(k8bytes, &cbkHolder); err != nil {
-		return cbk, err
-	}
-
-	var contextName, clusterName, userName string
-
-	contextName = cbkHolder.CurrentContext
-
-	for _, context := range cbkHolder.Contexts {
-		if context.Name == contextName {
-			clusterName = context.Context.Cluster
-			userName = context.Context.User
-			break
-		}
+	kc, err := ParseKubeConfig(k8bytes)
+	if err != nil {
+		return ClientBundleKube{Config: string(k8bytes)}, err
 	}
 
-	for _, cluster := range cbkHolder.Clusters {
-		if cluster.Name == clusterName {
-			cbk.Host = cluster.Cluster.Server
-			cbk.CACertificate = helperStringBase64Decode(cluster.Cluster.CertificateAuthorityData)
-			break
-		}
+	contextName := kc.bundleContextName()
+	if contextName == "" {
+		return ClientBundleKube{Config: string(k8bytes)}, nil
 	}
 
-	for _, user := range cbkHolder.Users {
-		if user.Name == userName {
-			cbk.ClientKey = helperStringBase64Decode(user.User.ClientKeyData)
-			cbk.ClientCertificate = helperStringBase64Decode(user.User.ClientCertificateData)
-			break
-		}
-	}
+	cbk, err := kc.ClientBundleKube(contextName)
+	cbk.Config = string(k8bytes)
 
-	return cbk, nil
+	return cbk, err
 }
 
 // NewClientBundleMetaFromReader interpret the meta.json file reader as a Meta struct
@@ -19,14 +19,17 @@ func (c *Client) doAuthorizedRequest(req *http.Request) (*Response, error) {
 	return c.doRequest(req)
 }
 
-// doRequest perform http request, catch http errors and return response as io.ReaderCloser.
-func (c *Client) doRequest(req *http.Request) (*Response, error) {
+// doRequest perform a traced http request, catch http errors and return response as io.ReaderCloser.
+func (c *Client) doRequest(req *http.Request) (res *Response, err error) {
+	req, span := startRequestSpan(req)
+	defer func() { endRequestSpan(span, res, err) }()
+
 	apiRes, err := c.HTTPClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("Error occurred in http request: %w \nreq: %s", err, requestDebug(req))
 	}
 
-	res := &Response{
+	res = &Response{
 		Response: apiRes,
 	}
 
@@ -36,6 +39,10 @@ func (c *Client) doRequest(req *http.Request) (*Response, error) {
 		if res.StatusCode == http.StatusUnauthorized {
 			return res, fmt.Errorf("%w: Unauthorized: %d : %s", ErrUnauthorizedReq, res.StatusCode, b)
 		}
+		if res.StatusCode == http.StatusForbidden {
+			// still a ResponseError, as it was before forbidden requests could be told apart
+			return res, fmt.Errorf("%w: %w: Forbidden: %d : %s", ErrResponseError, ErrForbiddenReq, res.StatusCode, b)
+		}
 		if res.StatusCode == http.StatusNotFound {
 			return res, fmt.Errorf("%w: Not Found: %d : %s", ErrUnknownTarget, res.StatusCode, b)
 		}
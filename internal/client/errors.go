@@ -3,16 +3,30 @@ package client
 import "errors"
 
 var (
-	ErrEmptyUsernamePass = errors.New("no username or password provided in MKE client")
-	ErrEmptyEndpoint     = errors.New("no endpoint provided in MKE client")
-	ErrRequestCreation   = errors.New("error creating request in MKE client")
-	ErrMarshaling        = errors.New("error occurred while marshalling struct in MKE client")
-	ErrUnmarshaling      = errors.New("error occurred while unmarshalling struct in MKE client")
-	ErrEmptyResError     = errors.New("request returned empty ResponseError struct in MKE client")
-	ErrResponseError     = errors.New("request returned ResponseError in MKE client")
-	ErrUnauthorizedReq   = errors.New("unauthorized request in MKE client")
-	ErrUnknownTarget     = errors.New("unknown API target")
-	ErrServerError       = errors.New("server error occurred")
-	ErrEmptyStruct       = errors.New("empty struct passed in MKE client")
-	ErrInvalidFilter     = errors.New("passing invalid account retrieval filter in MKE client")
+	ErrEmptyUsernamePass     = errors.New("no username or password provided in MKE client")
+	ErrEmptyEndpoint         = errors.New("no endpoint provided in MKE client")
+	ErrRequestCreation       = errors.New("error creating request in MKE client")
+	ErrMarshaling            = errors.New("error occurred while marshalling struct in MKE client")
+	ErrUnmarshaling          = errors.New("error occurred while unmarshalling struct in MKE client")
+	ErrEmptyResError         = errors.New("request returned empty ResponseError struct in MKE client")
+	ErrResponseError         = errors.New("request returned ResponseError in MKE client")
+	ErrUnauthorizedReq       = errors.New("unauthorized request in MKE client")
+	ErrForbiddenReq          = errors.New("forbidden request in MKE client")
+	ErrUnknownTarget         = errors.New("unknown API target")
+	ErrServerError           = errors.New("server error occurred")
+	ErrEmptyStruct           = errors.New("empty struct passed in MKE client")
+	ErrInvalidFilter         = errors.New("passing invalid account retrieval filter in MKE client")
+	ErrInvalidPasswordPolicy = errors.New("invalid password policy in MKE client")
+	ErrRepeatedPage          = errors.New("listing returned the same next page again in MKE client")
 )
+
+// IsNotFound does the error mean that the requested MKE object does not exist.
+// Connectivity, auth and server errors are never reported as not found.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrUnknownTarget)
+}
+
+// IsForbidden does the error mean that the MKE account used by the client is not allowed to make the request.
+func IsForbidden(err error) bool {
+	return errors.Is(err, ErrForbiddenReq)
+}
@@ -0,0 +1,253 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"gopkg.in/yaml.v2"
+)
+
+/**
+Kubeconfig handling
+
+MKE client bundles include a kubeconfig file (kube.yml). The parsing here is
+deliberately lenient: any fields that we do not know about are kept in the
+Extra maps, so that new kubeconfig features (exec users, extensions, etc.) do
+not break bundle creation, and are not lost when the config is rendered again.
+
+@see https://kubernetes.io/docs/reference/config-api/kubeconfig.v1/
+*/
+
+var (
+	ErrKubeConfigParse           = errors.New("could not parse kubeconfig")
+	ErrKubeConfigRender          = errors.New("could not render kubeconfig")
+	ErrKubeConfigContextNotFound = errors.New("kubeconfig context not found")
+)
+
+// KubeConfig a kubeconfig file.
+type KubeConfig struct {
+	APIVersion     string                 `yaml:"apiVersion,omitempty"`
+	Kind           string                 `yaml:"kind,omitempty"`
+	Preferences    map[string]interface{} `yaml:"preferences"`
+	Clusters       []KubeNamedCluster     `yaml:"clusters"`
+	Contexts       []KubeNamedContext     `yaml:"contexts"`
+	CurrentContext string                 `yaml:"current-context"`
+	Users          []KubeNamedUser        `yaml:"users"`
+	Extra          map[string]interface{} `yaml:",inline"`
+}
+
+// KubeNamedCluster a kubeconfig clusters entry.
+type KubeNamedCluster struct {
+	Name    string                 `yaml:"name"`
+	Cluster KubeCluster            `yaml:"cluster"`
+	Extra   map[string]interface{} `yaml:",inline"`
+}
+
+// KubeCluster connection details for a kubernetes API.
+type KubeCluster struct {
+	Server                   string                 `yaml:"server"`
+	CertificateAuthorityData string                 `yaml:"certificate-authority-data,omitempty"`
+	CertificateAuthority     string                 `yaml:"certificate-authority,omitempty"`
+	InsecureSkipTLSVerify    bool                   `yaml:"insecure-skip-tls-verify,omitempty"`
+	Extra                    map[string]interface{} `yaml:",inline"`
+}
+
+// KubeNamedContext a kubeconfig contexts entry.
+type KubeNamedContext struct {
+	Name    string                 `yaml:"name"`
+	Context KubeContext            `yaml:"context"`
+	Extra   map[string]interface{} `yaml:",inline"`
+}
+
+// KubeContext a cluster/user pair, with an optional default namespace.
+type KubeContext struct {
+	Cluster   string                 `yaml:"cluster"`
+	User      string                 `yaml:"user"`
+	Namespace string                 `yaml:"namespace,omitempty"`
+	Extra     map[string]interface{} `yaml:",inline"`
+}
+
+// KubeNamedUser a kubeconfig users entry.
+type KubeNamedUser struct {
+	Name  string                 `yaml:"name"`
+	User  KubeUser               `yaml:"user"`
+	Extra map[string]interface{} `yaml:",inline"`
+}
+
+// KubeUser client credentials. Exec/auth-provider users are kept in Extra.
+type KubeUser struct {
+	ClientCertificateData string                 `yaml:"client-certificate-data,omitempty"`
+	ClientKeyData         string                 `yaml:"client-key-data,omitempty"`
+	Token                 string                 `yaml:"token,omitempty"`
+	Extra                 map[string]interface{} `yaml:",inline"`
+}
+
+// KubeConfigRename new names for a context and the cluster and user that it uses.
+// Empty names are left unchanged.
+type KubeConfigRename struct {
+	Context string
+	Cluster string
+	User    string
+}
+
+// ParseKubeConfig parse a kubeconfig, keeping any fields that are not explicitly handled.
+func ParseKubeConfig(b []byte) (KubeConfig, error) {
+	var kc KubeConfig
+
+	if err := yaml.Unmarshal(b, &kc); err != nil {
+		return kc, fmt.Errorf("%w; %s", ErrKubeConfigParse, err)
+	}
+
+	return kc, nil
+}
+
+// Render the kubeconfig as yaml.
+func (kc KubeConfig) Render() ([]byte, error) {
+	b, err := yaml.Marshal(kc)
+	if err != nil {
+		return nil, fmt.Errorf("%w; %s", ErrKubeConfigRender, err)
+	}
+	return b, nil
+}
+
+// ContextNames names of all of the contexts, in file order.
+func (kc KubeConfig) ContextNames() []string {
+	names := make([]string, 0, len(kc.Contexts))
+	for _, c := range kc.Contexts {
+		names = append(names, c.Name)
+	}
+	return names
+}
+
+// DefaultContextName the current context, or the only context if no current context is set.
+func (kc KubeConfig) DefaultContextName() string {
+	if kc.CurrentContext == "" && len(kc.Contexts) == 1 {
+		return kc.Contexts[0].Name
+	}
+	return kc.CurrentContext
+}
+
+// bundleContextName the context which the values of a client bundle are read from: the default context if it
+// exists, otherwise the first context, or "" if there are none. MKE has already made the bundle key by the time
+// that its kubeconfig is parsed, so a current context which does not match must not fail the bundle.
+func (kc KubeConfig) bundleContextName() string {
+	name := kc.DefaultContextName()
+	for _, c := range kc.Contexts {
+		if c.Name == name {
+			return name
+		}
+	}
+	if len(kc.Contexts) > 0 {
+		return kc.Contexts[0].Name
+	}
+	return ""
+}
+
+// ClientBundleKube build the client bundle kube values for a context.
+// An empty context name uses the default context.
+func (kc KubeConfig) ClientBundleKube(contextName string) (ClientBundleKube, error) {
+	var cbk ClientBundleKube
+
+	if contextName == "" {
+		contextName = kc.DefaultContextName()
+	}
+
+	var context *KubeNamedContext
+	for i := range kc.Contexts {
+		if kc.Contexts[i].Name == contextName {
+			context = &kc.Contexts[i]
+			break
+		}
+	}
+	if context == nil {
+		return cbk, fmt.Errorf("%w; %q not in %v", ErrKubeConfigContextNotFound, contextName, kc.ContextNames())
+	}
+
+	cbk.ContextName = context.Name
+	cbk.ClusterName = context.Context.Cluster
+	cbk.UserName = context.Context.User
+	cbk.Namespace = context.Context.Namespace
+
+	for _, cluster := range kc.Clusters {
+		if cluster.Name == cbk.ClusterName {
+			cbk.Host = cluster.Cluster.Server
+			cbk.CACertificate = helperStringBase64Decode(cluster.Cluster.CertificateAuthorityData)
+			cbk.Insecure = strconv.FormatBool(cluster.Cluster.InsecureSkipTLSVerify)
+			break
+		}
+	}
+
+	for _, user := range kc.Users {
+		if user.Name == cbk.UserName {
+			cbk.ClientKey = helperStringBase64Decode(user.User.ClientKeyData)
+			cbk.ClientCertificate = helperStringBase64Decode(user.User.ClientCertificateData)
+			break
+		}
+	}
+
+	return cbk, nil
+}
+
+// Rename a context, and the cluster and user that it uses, so that the config can be merged into
+// another kubeconfig without name collisions. All references to renamed entries are updated.
+// The receiver is not modified.
+func (kc KubeConfig) Rename(contextName string, rn KubeConfigRename) (KubeConfig, error) {
+	out := kc
+	out.Clusters = append([]KubeNamedCluster{}, kc.Clusters...)
+	out.Contexts = append([]KubeNamedContext{}, kc.Contexts...)
+	out.Users = append([]KubeNamedUser{}, kc.Users...)
+
+	if contextName == "" {
+		contextName = kc.DefaultContextName()
+	}
+
+	ci := -1
+	for i, c := range out.Contexts {
+		if c.Name == contextName {
+			ci = i
+			break
+		}
+	}
+	if ci < 0 {
+		return kc, fmt.Errorf("%w; %q not in %v", ErrKubeConfigContextNotFound, contextName, kc.ContextNames())
+	}
+
+	oldCluster := out.Contexts[ci].Context.Cluster
+	oldUser := out.Contexts[ci].Context.User
+
+	if rn.Cluster != "" && rn.Cluster != oldCluster {
+		for i := range out.Clusters {
+			if out.Clusters[i].Name == oldCluster {
+				out.Clusters[i].Name = rn.Cluster
+			}
+		}
+		for i := range out.Contexts {
+			if out.Contexts[i].Context.Cluster == oldCluster {
+				out.Contexts[i].Context.Cluster = rn.Cluster
+			}
+		}
+	}
+
+	if rn.User != "" && rn.User != oldUser {
+		for i := range out.Users {
+			if out.Users[i].Name == oldUser {
+				out.Users[i].Name = rn.User
+			}
+		}
+		for i := range out.Contexts {
+			if out.Contexts[i].Context.User == oldUser {
+				out.Contexts[i].Context.User = rn.User
+			}
+		}
+	}
+
+	if rn.Context != "" && rn.Context != contextName {
+		out.Contexts[ci].Name = rn.Context
+		if out.CurrentContext == contextName {
+			out.CurrentContext = rn.Context
+		}
+	}
+
+	return out, nil
+}
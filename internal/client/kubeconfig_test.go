@@ -0,0 +1,196 @@
+package client_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+var (
+	// a kube yaml file with several contexts, and fields that MKE may add
+	// @note some values are base64 encoded
+	MultiContextKubeYml = `
+apiVersion: v1
+kind: Config
+preferences: {}
+extensions:
+- name: mke
+  extension:
+    version: 3.7.5
+
+clusters:
+- name: mke_cluster
+  cluster:
+    certificate-authority-data: RUZHSElK
+    server: https://mke.example:6443
+    insecure-skip-tls-verify: true
+- name: other_cluster
+  cluster:
+    server: https://other.example:6443
+    proxy-url: http://proxy.example:3128
+
+contexts:
+- name: mke
+  context:
+    cluster: mke_cluster
+    user: mke_user
+    namespace: team-a
+- name: other
+  context:
+    cluster: other_cluster
+    user: exec_user
+
+current-context: mke
+users:
+- name: mke_user
+  user:
+    client-certificate-data: QUJDREU=
+    client-key-data: QkNERUZH
+- name: exec_user
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: mke-login
+      args:
+      - --cluster
+      - other
+`
+)
+
+func TestKubeConfigTolerantParse(t *testing.T) {
+	buf := bytes.NewBuffer([]byte(MultiContextKubeYml))
+	cbk, err := client.NewClientBundleKubeFromKubeYml(buf)
+	if err != nil {
+		t.Fatalf("Error converting Kube CB from yaml with unknown fields: %s", err)
+	}
+
+	if cbk.Host != "https://mke.example:6443" {
+		t.Errorf("CBK from yaml got the wrong host: %+v", cbk)
+	}
+	if cbk.Insecure != "true" {
+		t.Errorf("CBK from yaml got the wrong Insecure: %+v", cbk)
+	}
+	if cbk.Namespace != "team-a" {
+		t.Errorf("CBK from yaml got the wrong Namespace: %+v", cbk)
+	}
+	if cbk.ContextName != "mke" || cbk.ClusterName != "mke_cluster" || cbk.UserName != "mke_user" {
+		t.Errorf("CBK from yaml got the wrong entry names: %+v", cbk)
+	}
+	if cbk.ClientKey != "BCDEFG" || cbk.ClientCertificate != "ABCDE" || cbk.CACertificate != "EFGHIJ" {
+		t.Errorf("CBK from yaml got the wrong credentials: %+v", cbk)
+	}
+}
+
+func TestKubeConfigOtherContext(t *testing.T) {
+	kc, err := client.ParseKubeConfig([]byte(MultiContextKubeYml))
+	if err != nil {
+		t.Fatalf("could not parse kubeconfig: %s", err)
+	}
+
+	if names := kc.ContextNames(); len(names) != 2 || names[1] != "other" {
+		t.Errorf("unexpected context names: %v", names)
+	}
+
+	cbk, err := kc.ClientBundleKube("other")
+	if err != nil {
+		t.Fatalf("could not read the other context: %s", err)
+	}
+	if cbk.Host != "https://other.example:6443" || cbk.Insecure != "false" || cbk.ClientKey != "" {
+		t.Errorf("other context was interpreted wrongly: %+v", cbk)
+	}
+
+	if _, err := kc.ClientBundleKube("missing"); !errors.Is(err, client.ErrKubeConfigContextNotFound) {
+		t.Errorf("expected a missing context error, got: %v", err)
+	}
+}
+
+func TestKubeConfigBundleWithoutMatchingContext(t *testing.T) {
+	kubeYml := strings.Replace(MultiContextKubeYml, "current-context: mke", "current-context: missing", 1)
+
+	cbk, err := client.NewClientBundleKubeFromKubeYml(bytes.NewBufferString(kubeYml))
+	if err != nil {
+		t.Fatalf("a bundle with a missing current context should use the first context: %s", err)
+	}
+	if cbk.ContextName != "mke" || cbk.Host != "https://mke.example:6443" {
+		t.Errorf("expected the values of the first context, got: %+v", cbk)
+	}
+
+	cbk, err = client.NewClientBundleKubeFromKubeYml(bytes.NewBufferString("apiVersion: v1\nkind: Config\n"))
+	if err != nil {
+		t.Fatalf("a bundle without contexts should not fail: %s", err)
+	}
+	if cbk.Host != "" || cbk.Config == "" {
+		t.Errorf("expected empty values and the config for a bundle without contexts, got: %+v", cbk)
+	}
+
+	// explicitly asking for the missing context is still an error
+	kc, _ := client.ParseKubeConfig([]byte(kubeYml))
+	if _, err := kc.ClientBundleKube("missing"); !errors.Is(err, client.ErrKubeConfigContextNotFound) {
+		t.Errorf("expected a missing context error, got: %v", err)
+	}
+}
+
+func TestKubeConfigSingleContextWithoutCurrent(t *testing.T) {
+	kc, err := client.ParseKubeConfig([]byte(strings.Replace(GoodKubeYml, "current-context: 6443_admin", "", 1)))
+	if err != nil {
+		t.Fatalf("could not parse kubeconfig: %s", err)
+	}
+
+	cbk, err := kc.ClientBundleKube("")
+	if err != nil {
+		t.Fatalf("the only context should be used when there is no current context: %s", err)
+	}
+	if cbk.Host != "localhost:6443" {
+		t.Errorf("wrong host for the only context: %+v", cbk)
+	}
+}
+
+func TestKubeConfigRenameAndRender(t *testing.T) {
+	kc, err := client.ParseKubeConfig([]byte(MultiContextKubeYml))
+	if err != nil {
+		t.Fatalf("could not parse kubeconfig: %s", err)
+	}
+
+	renamed, err := kc.Rename("", client.KubeConfigRename{
+		Context: "prod",
+		Cluster: "prod-cluster",
+		User:    "prod-admin",
+	})
+	if err != nil {
+		t.Fatalf("could not rename the kubeconfig: %s", err)
+	}
+
+	if kc.CurrentContext != "mke" || kc.Contexts[0].Name != "mke" || kc.Clusters[0].Name != "mke_cluster" {
+		t.Error("rename modified the original kubeconfig")
+	}
+
+	b, err := renamed.Render()
+	if err != nil {
+		t.Fatalf("could not render the kubeconfig: %s", err)
+	}
+
+	reparsed, err := client.ParseKubeConfig(b)
+	if err != nil {
+		t.Fatalf("could not parse the rendered kubeconfig: %s\n%s", err, b)
+	}
+
+	cbk, err := reparsed.ClientBundleKube("")
+	if err != nil {
+		t.Fatalf("rendered kubeconfig has no current context: %s\n%s", err, b)
+	}
+	if cbk.ContextName != "prod" || cbk.ClusterName != "prod-cluster" || cbk.UserName != "prod-admin" {
+		t.Errorf("rendered kubeconfig has the wrong names: %+v", cbk)
+	}
+	if cbk.Host != "https://mke.example:6443" || cbk.ClientKey != "BCDEFG" || cbk.Namespace != "team-a" {
+		t.Errorf("rendered kubeconfig lost values: %+v", cbk)
+	}
+
+	for _, kept := range []string{"extensions:", "proxy-url:", "command: mke-login", "other_cluster", "exec_user"} {
+		if !strings.Contains(string(b), kept) {
+			t.Errorf("rendered kubeconfig dropped %q:\n%s", kept, b)
+		}
+	}
+}
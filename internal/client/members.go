@@ -0,0 +1,74 @@
+package client
+
+/**
+Membership abstractions, shared by org and team memberships.
+
+@see https://github.com/Mirantis/orca/blob/master/enzi/api/responses/responses.go
+*/
+
+import "sort"
+
+// MemberForm request body for adding a member, or changing its admin flag.
+type MemberForm struct {
+	IsAdmin bool `json:"isAdmin"`
+}
+
+// ResponseMember a membership: the member account, and whether it administers the org or team.
+type ResponseMember struct {
+	Member  ResponseAccount `json:"member"`
+	IsAdmin bool            `json:"isAdmin"`
+}
+
+// ResponseMembers struct.
+type ResponseMembers struct {
+	NextPageStart string           `json:"nextPageStart"`
+	Members       []ResponseMember `json:"members"`
+}
+
+// MembersDiff the changes needed to turn a current member set into a desired one.
+type MembersDiff struct {
+	// Set members to add, or whose admin flag must change, by name.
+	Set map[string]bool
+	// Remove names of members which are not desired.
+	Remove []string
+}
+
+// Empty does the diff contain no changes.
+func (d MembersDiff) Empty() bool {
+	return len(d.Set) == 0 && len(d.Remove) == 0
+}
+
+// SetNames the names of the members to set, sorted.
+func (d MembersDiff) SetNames() []string {
+	names := make([]string, 0, len(d.Set))
+	for name := range d.Set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DiffMembers compute the minimal changes to get from the current members to the desired
+// member names and admin flags. Members that already match are not included.
+func DiffMembers(current []ResponseMember, desired map[string]bool) MembersDiff {
+	d := MembersDiff{Set: map[string]bool{}, Remove: []string{}}
+
+	have := map[string]bool{}
+	for _, m := range current {
+		have[m.Member.Name] = m.IsAdmin
+	}
+
+	for name, isAdmin := range desired {
+		if wasAdmin, ok := have[name]; !ok || wasAdmin != isAdmin {
+			d.Set[name] = isAdmin
+		}
+	}
+	for name := range have {
+		if _, ok := desired[name]; !ok {
+			d.Remove = append(d.Remove, name)
+		}
+	}
+	sort.Strings(d.Remove)
+
+	return d
+}
@@ -0,0 +1,48 @@
+package client_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+func member(name string, isAdmin bool) client.ResponseMember {
+	return client.ResponseMember{Member: client.ResponseAccount{Name: name}, IsAdmin: isAdmin}
+}
+
+func TestDiffMembers(t *testing.T) {
+	current := []client.ResponseMember{
+		member("keep", false),
+		member("promote", false),
+		member("demote", true),
+		member("drop", false),
+	}
+	desired := map[string]bool{
+		"keep":    false,
+		"promote": true,
+		"demote":  false,
+		"add":     false,
+	}
+
+	d := client.DiffMembers(current, desired)
+
+	expectedSet := map[string]bool{"promote": true, "demote": false, "add": false}
+	if !reflect.DeepEqual(d.Set, expectedSet) {
+		t.Errorf("wrong members to set: %+v", d.Set)
+	}
+	if !reflect.DeepEqual(d.Remove, []string{"drop"}) {
+		t.Errorf("wrong members to remove: %+v", d.Remove)
+	}
+	if !reflect.DeepEqual(d.SetNames(), []string{"add", "demote", "promote"}) {
+		t.Errorf("set names are not sorted: %+v", d.SetNames())
+	}
+}
+
+func TestDiffMembersNoChanges(t *testing.T) {
+	current := []client.ResponseMember{member("a", true), member("b", false)}
+
+	if d := client.DiffMembers(current, map[string]bool{"a": true, "b": false}); !d.Empty() {
+		t.Errorf("expected no changes, got %+v", d)
+	}
+}
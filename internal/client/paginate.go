@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// listAllPages retrieve every page of an eNZi listing at the target, following nextPageStart.
+// Each page is requested with a new request, so that no headers carry over from the previous page.
+// The items function returns the items of a page and the start of the next page, or "" for the last page.
+// A server which sends the same next page again would be followed forever, so that is an error.
+// Errors are wrapped by the caller with what was being listed.
+func listAllPages[P any, T any](ctx context.Context, c *Client, target string, query url.Values, items func(P) ([]T, string)) ([]T, error) {
+	all := []T{}
+	start := ""
+
+	for {
+		req, err := c.RequestFromTargetAndBytesBody(ctx, http.MethodGet, target, []byte{})
+		if err != nil {
+			return all, fmt.Errorf("%w: %s", ErrRequestCreation, err)
+		}
+
+		q := req.URL.Query()
+		for k, vs := range query {
+			for _, v := range vs {
+				q.Add(k, v)
+			}
+		}
+		if start != "" {
+			q.Set(URLQueryPageStart, start)
+		}
+		req.URL.RawQuery = q.Encode()
+
+		resp, err := c.doAuthorizedRequest(req)
+		if err != nil {
+			return all, err
+		}
+
+		var page P
+		if err := resp.JSONMarshallBody(&page); err != nil {
+			return all, fmt.Errorf("%w: %s", ErrUnmarshaling, err)
+		}
+
+		pageItems, next := items(page)
+		all = append(all, pageItems...)
+
+		if next == "" {
+			return all, nil
+		}
+		if next == start {
+			return all, fmt.Errorf("%w: %s", ErrRepeatedPage, next)
+		}
+		start = next
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"strings"
 )
 
 // RequestFromTarget build simple http.Request from relative API target and bytes array for a body.
@@ -37,10 +38,37 @@ func requestDebug(req *http.Request) string {
 		Body    string      `json:"body"`
 	}{
 		Headers: req.Header,
-		Body:    string(bb),
+		Body:    redactPasswords(bb),
 	}
 
 	rj, _ := json.MarshalIndent(re, "\n", "  ")
 
 	return string(rj)
 }
+
+// redactPasswords hide the values of password fields in a JSON request body, so that they don't end up in error messages.
+// Bodies which are not JSON objects are returned as they are.
+func redactPasswords(body []byte) string {
+	var fields map[string]interface{}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return string(body)
+	}
+
+	redactPasswordFields(fields)
+
+	rb, _ := json.Marshal(fields)
+	return string(rb)
+}
+
+func redactPasswordFields(fields map[string]interface{}) {
+	for k, v := range fields {
+		switch fv := v.(type) {
+		case string:
+			if fv != "" && strings.Contains(strings.ToLower(k), "password") {
+				fields[k] = "REDACTED"
+			}
+		case map[string]interface{}:
+			redactPasswordFields(fv)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package client
+
+/**
+
+# Tracing
+
+Every http request made by the client is wrapped in an OpenTelemetry span.
+The tracer is retrieved from the global TracerProvider, so unless the provider
+process (or a test) installs a real TracerProvider, tracing is a no-op.
+
+The W3C trace context of the span is injected into the request headers, so that
+MKE side request logs can be correlated with the terraform operation.
+
+*/
+
+import (
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// TracerName instrumentation name used for client spans.
+	TracerName = "github.com/Mirantis/terraform-provider-mke/internal/client"
+
+	TraceAttributeTarget     = "mke.target"
+	TraceAttributeMethod     = "http.method"
+	TraceAttributeStatusCode = "http.status_code"
+)
+
+// startRequestSpan start a client span for an http request, and inject the trace context into its headers.
+// The span is named by the method only, as the paths include account, team and key names, which would make
+// every object its own span name. The path is kept in the target attribute.
+func startRequestSpan(req *http.Request) (*http.Request, trace.Span) {
+	ctx, span := otel.Tracer(TracerName).Start(
+		req.Context(),
+		fmt.Sprintf("MKE %s", req.Method),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String(TraceAttributeTarget, req.URL.Path),
+			attribute.String(TraceAttributeMethod, req.Method),
+		),
+	)
+
+	req = req.WithContext(ctx)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	return req, span
+}
+
+// endRequestSpan record the request outcome on the span and end it.
+func endRequestSpan(span trace.Span, res *Response, err error) {
+	if res != nil && res.Response != nil {
+		span.SetAttributes(attribute.Int(TraceAttributeStatusCode, res.StatusCode))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
@@ -0,0 +1,88 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+// installTestTracer capture spans in memory for the duration of a test.
+func installTestTracer(t *testing.T) *tracetest.InMemoryExporter {
+	exp := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp))
+
+	prevTP := otel.GetTracerProvider()
+	prevProp := otel.GetTextMapPropagator()
+
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevTP)
+		otel.SetTextMapPropagator(prevProp)
+	})
+
+	return exp
+}
+
+func spanAttribute(s tracetest.SpanStub, key string) (attribute.Value, bool) {
+	for _, a := range s.Attributes {
+		if string(a.Key) == key {
+			return a.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func TestRequestSpanAndTraceContext(t *testing.T) {
+	exp := installTestTracer(t)
+	ctx := context.Background()
+	auth := commonTestAuth
+
+	var receivedTraceID trace.TraceID
+
+	s := NewMockTestServer(&auth, t)
+	s.AddHandler(http.MethodGet, client.URLTargetForPing, func(w http.ResponseWriter, r *http.Request) {
+		rctx := propagation.TraceContext{}.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		receivedTraceID = trace.SpanContextFromContext(rctx).TraceID()
+		w.WriteHeader(http.StatusOK)
+	})
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	if err := c.ApiPing(ctx); err != nil {
+		t.Fatalf("Could not make a ping: %s", err)
+	}
+
+	spans := exp.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("expected one span, got %d: %+v", len(spans), spans)
+	}
+	span := spans[0]
+
+	if !receivedTraceID.IsValid() {
+		t.Error("no W3C trace context was propagated in the request headers")
+	} else if receivedTraceID != span.SpanContext.TraceID() {
+		t.Errorf("propagated trace id does not match the request span: %s != %s", receivedTraceID, span.SpanContext.TraceID())
+	}
+
+	if span.Name != "MKE GET" {
+		t.Errorf("span should be named by the method only, got %q", span.Name)
+	}
+	if v, ok := spanAttribute(span, client.TraceAttributeTarget); !ok || v.AsString() != "/"+client.URLTargetForPing {
+		t.Errorf("span has wrong target attribute: %+v", span.Attributes)
+	}
+	if v, ok := spanAttribute(span, client.TraceAttributeStatusCode); !ok || v.AsInt64() != http.StatusOK {
+		t.Errorf("span has wrong status attribute: %+v", span.Attributes)
+	}
+}
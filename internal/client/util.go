@@ -1,17 +1,161 @@
 package client
 
 import (
-	"math/rand"
-	"time"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
 )
 
-// GeneratePass creates a random password.
-func GeneratePass() string {
-	var letters = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ123456789!@#$")
-	rand.New(rand.NewSource(time.Now().UnixNano()))
-	b := make([]rune, 8)
-	for i := range b {
-		b[i] = letters[rand.Intn(len(letters))]
+const (
+	PasswordCharsUpper   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	PasswordCharsLower   = "abcdefghijklmnopqrstuvwxyz"
+	PasswordCharsNumeric = "0123456789"
+	PasswordCharsSpecial = "!@#$%&*()-_=+[]{}<>:?"
+
+	// DefaultPasswordLength the length of generated passwords when the policy does not set one.
+	DefaultPasswordLength = 16
+)
+
+// PasswordPolicy how GeneratePassword builds a password.
+type PasswordPolicy struct {
+	Length int
+	// Character classes used in the password
+	Upper   bool
+	Lower   bool
+	Numeric bool
+	Special bool
+	// Minimum number of characters from each class
+	MinUpper   int
+	MinLower   int
+	MinNumeric int
+	MinSpecial int
+	// OverrideSpecial replaces the default special characters, if not empty
+	OverrideSpecial string
+	// Exclude characters that are never used
+	Exclude string
+}
+
+// DefaultPasswordPolicy a 16 character password using all of the character classes.
+func DefaultPasswordPolicy() PasswordPolicy {
+	return PasswordPolicy{
+		Length:  DefaultPasswordLength,
+		Upper:   true,
+		Lower:   true,
+		Numeric: true,
+		Special: true,
+	}
+}
+
+// GeneratePassword creates a random password from the policy, using crypto/rand.
+// Every enabled class gets at least its minimum number of characters, and the rest are
+// drawn from all of the enabled classes.
+func GeneratePassword(policy PasswordPolicy) (string, error) {
+	special := PasswordCharsSpecial
+	if policy.OverrideSpecial != "" {
+		special = policy.OverrideSpecial
+	}
+
+	classes := []struct {
+		name    string
+		enabled bool
+		chars   string
+		min     int
+	}{
+		{"upper", policy.Upper, PasswordCharsUpper, policy.MinUpper},
+		{"lower", policy.Lower, PasswordCharsLower, policy.MinLower},
+		{"numeric", policy.Numeric, PasswordCharsNumeric, policy.MinNumeric},
+		{"special", policy.Special, special, policy.MinSpecial},
+	}
+
+	if policy.Length < 1 {
+		return "", fmt.Errorf("%w: the length must be positive, got %d", ErrInvalidPasswordPolicy, policy.Length)
+	}
+
+	all := ""
+	required := 0
+	password := make([]rune, 0, policy.Length)
+	for _, class := range classes {
+		if !class.enabled {
+			if class.min > 0 {
+				return "", fmt.Errorf("%w: a minimum of %d %s characters needs the class to be enabled", ErrInvalidPasswordPolicy, class.min, class.name)
+			}
+			continue
+		}
+
+		chars := removeChars(class.chars, policy.Exclude)
+		if chars == "" {
+			return "", fmt.Errorf("%w: all of the %s characters are excluded", ErrInvalidPasswordPolicy, class.name)
+		}
+		all += chars
+
+		required += class.min
+		if required > policy.Length {
+			return "", fmt.Errorf("%w: the minimum character counts add up to more than the length %d", ErrInvalidPasswordPolicy, policy.Length)
+		}
+
+		for i := 0; i < class.min; i++ {
+			c, err := randomChar(chars)
+			if err != nil {
+				return "", err
+			}
+			password = append(password, c)
+		}
+	}
+
+	if all == "" {
+		return "", fmt.Errorf("%w: no character classes are enabled", ErrInvalidPasswordPolicy)
+	}
+
+	for len(password) < policy.Length {
+		c, err := randomChar(all)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+	}
+
+	// shuffle, so that the required characters are not always first
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := randomInt(i + 1)
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j] = password[j], password[i]
+	}
+
+	return string(password), nil
+}
+
+// Ptr a pointer to a copy of v, for optional request fields.
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// removeChars the characters of s which are not in exclude, without duplicates.
+func removeChars(s, exclude string) string {
+	var b strings.Builder
+	for _, c := range s {
+		if !strings.ContainsRune(exclude, c) && !strings.ContainsRune(b.String(), c) {
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
+
+func randomChar(chars string) (rune, error) {
+	runes := []rune(chars)
+	i, err := randomInt(len(runes))
+	if err != nil {
+		return 0, err
+	}
+	return runes[i], nil
+}
+
+func randomInt(n int) (int, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, fmt.Errorf("generating password failed. %w", err)
 	}
-	return string(b)
+	return int(i.Int64()), nil
 }
@@ -0,0 +1,104 @@
+package client_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+func TestGeneratePasswordDefaultPolicy(t *testing.T) {
+	seen := map[string]bool{}
+	for i := 0; i < 20; i++ {
+		pass, err := client.GeneratePassword(client.DefaultPasswordPolicy())
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(pass) != client.DefaultPasswordLength {
+			t.Errorf("expected a password of length %d, got %q", client.DefaultPasswordLength, pass)
+		}
+		if seen[pass] {
+			t.Errorf("generated the same password twice: %q", pass)
+		}
+		seen[pass] = true
+	}
+}
+
+func TestGeneratePasswordMinimums(t *testing.T) {
+	policy := client.PasswordPolicy{
+		Length:     12,
+		Upper:      true,
+		Numeric:    true,
+		Special:    true,
+		MinUpper:   3,
+		MinNumeric: 4,
+		MinSpecial: 5,
+	}
+
+	for i := 0; i < 20; i++ {
+		pass, err := client.GeneratePassword(policy)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if strings.ContainsAny(pass, client.PasswordCharsLower) {
+			t.Errorf("lower case characters are disabled, got %q", pass)
+		}
+		for _, c := range []struct {
+			chars string
+			min   int
+		}{
+			{client.PasswordCharsUpper, 3},
+			{client.PasswordCharsNumeric, 4},
+			{client.PasswordCharsSpecial, 5},
+		} {
+			if n := countChars(pass, c.chars); n != c.min {
+				t.Errorf("expected exactly %d of %q in %q, got %d", c.min, c.chars, pass, n)
+			}
+		}
+	}
+}
+
+func TestGeneratePasswordExcludeAndOverride(t *testing.T) {
+	policy := client.PasswordPolicy{
+		Length:          32,
+		Lower:           true,
+		Numeric:         true,
+		Special:         true,
+		MinSpecial:      2,
+		OverrideSpecial: "_-",
+		Exclude:         "abcdefghijklmnopqrstuvwxy01234567",
+	}
+
+	pass, err := client.GeneratePassword(policy)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if strings.Trim(pass, "z89_-") != "" {
+		t.Errorf("expected only z, 8, 9, _ and -, got %q", pass)
+	}
+}
+
+func TestGeneratePasswordInvalidPolicy(t *testing.T) {
+	for name, policy := range map[string]client.PasswordPolicy{
+		"zero length":       {Lower: true},
+		"no classes":        {Length: 16},
+		"minimum too large": {Length: 4, Lower: true, Upper: true, MinLower: 3, MinUpper: 2},
+		"disabled minimum":  {Length: 16, Lower: true, MinNumeric: 1},
+		"all excluded":      {Length: 16, Numeric: true, Exclude: client.PasswordCharsNumeric},
+	} {
+		if _, err := client.GeneratePassword(policy); !errors.Is(err, client.ErrInvalidPasswordPolicy) {
+			t.Errorf("%s: expected ErrInvalidPasswordPolicy, got %v", name, err)
+		}
+	}
+}
+
+func countChars(s, chars string) int {
+	n := 0
+	for _, c := range s {
+		if strings.ContainsRune(chars, c) {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,461 @@
+package mketest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+const (
+	// DefaultPageLimit page size used for listings when the request does not pass a limit.
+	DefaultPageLimit = 100
+
+	QueryFilter = "filter"
+	QueryStart  = "start"
+	QueryLimit  = "limit"
+)
+
+// createAccountForm request body for account creation.
+type createAccountForm struct {
+	Name       string `json:"name"`
+	Password   string `json:"password"`
+	FullName   string `json:"fullName"`
+	IsActive   bool   `json:"isActive"`
+	IsAdmin    bool   `json:"isAdmin"`
+	IsOrg      bool   `json:"isOrg"`
+	SearchLDAP bool   `json:"searchLDAP"`
+}
+
+// updateAccountForm request body for account updates, nil fields are left unchanged.
+type updateAccountForm struct {
+	FullName *string `json:"fullName"`
+	IsActive *bool   `json:"isActive"`
+	IsAdmin  *bool   `json:"isAdmin"`
+}
+
+// CreateAccount add an account directly to the server state, as if it was made outside of terraform.
+func (s *Server) CreateAccount(acc client.CreateAccount) client.ResponseAccount {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.createAccount(createAccountForm{
+		Name:       acc.Name,
+		Password:   acc.Password,
+		FullName:   acc.FullName,
+		IsActive:   acc.IsActive,
+		IsAdmin:    acc.IsAdmin,
+		IsOrg:      acc.IsOrg,
+		SearchLDAP: acc.SearchLDAP,
+	}).ResponseAccount
+}
+
+// Account retrieve the current server state of an account by name or ID.
+func (s *Server) Account(nameOrID string) (client.ResponseAccount, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	acc := s.findAccount(nameOrID)
+	if acc == nil {
+		return client.ResponseAccount{}, false
+	}
+	return acc.ResponseAccount, true
+}
+
+// AccountPassword the current password of an account, so tests can confirm password changes.
+func (s *Server) AccountPassword(nameOrID string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	acc := s.findAccount(nameOrID)
+	if acc == nil {
+		return "", false
+	}
+	return acc.password, true
+}
+
+// PasswordChanges how many times the password of an account was changed through the API,
+// so tests can confirm that no password was sent.
+func (s *Server) PasswordChanges(nameOrID string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	acc := s.findAccount(nameOrID)
+	if acc == nil {
+		return 0
+	}
+	return acc.passwordChanges
+}
+
+// ModifyAccount change an account in the server state, as if it was changed outside of terraform.
+func (s *Server) ModifyAccount(nameOrID string, modify func(acc *client.ResponseAccount)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	acc := s.findAccount(nameOrID)
+	if acc == nil {
+		return false
+	}
+	modify(&acc.ResponseAccount)
+	return true
+}
+
+// RemoveAccount delete an account from the server state, as if it was deleted outside of terraform.
+func (s *Server) RemoveAccount(nameOrID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	acc := s.findAccount(nameOrID)
+	if acc == nil {
+		return false
+	}
+	s.deleteAccount(acc)
+	return true
+}
+
+// AddPublicKey register a public key for an account, as if the user made a client bundle.
+func (s *Server) AddPublicKey(nameOrID, label string) (client.AccountPublicKey, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	acc := s.findAccount(nameOrID)
+	if acc == nil {
+		return client.AccountPublicKey{}, false
+	}
+	key := client.AccountPublicKey{
+		ID:        randomHex(32),
+		AccountID: acc.ID,
+		PublicKey: "-----BEGIN PUBLIC KEY-----\n-----END PUBLIC KEY-----\n",
+		Label:     label,
+	}
+	acc.publicKeys = append(acc.publicKeys, key)
+	return key, true
+}
+
+// PublicKeys the public keys currently registered for an account.
+func (s *Server) PublicKeys(nameOrID string) []client.AccountPublicKey {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	acc := s.findAccount(nameOrID)
+	if acc == nil {
+		return nil
+	}
+	return append([]client.AccountPublicKey{}, acc.publicKeys...)
+}
+
+// findAccount by name or ID. The caller must hold the lock.
+func (s *Server) findAccount(nameOrID string) *account {
+	if acc, ok := s.accounts[nameOrID]; ok {
+		return acc
+	}
+	for _, acc := range s.accounts {
+		if acc.Name == nameOrID {
+			return acc
+		}
+	}
+	return nil
+}
+
+// sortedAccounts all accounts sorted by name. The caller must hold the lock.
+func (s *Server) sortedAccounts() []*account {
+	accs := make([]*account, 0, len(s.accounts))
+	for _, acc := range s.accounts {
+		accs = append(accs, acc)
+	}
+	sort.Slice(accs, func(i, j int) bool { return accs[i].Name < accs[j].Name })
+	return accs
+}
+
+// createAccount the caller must hold the lock.
+func (s *Server) createAccount(f createAccountForm) *account {
+	acc := &account{
+		ResponseAccount: client.ResponseAccount{
+			ID:       newID(),
+			Name:     f.Name,
+			FullName: f.FullName,
+			IsOrg:    f.IsOrg,
+		},
+		password: f.Password,
+	}
+
+	if !f.IsOrg {
+		acc.IsActive = f.IsActive
+		acc.IsAdmin = f.IsAdmin
+	}
+	if f.SearchLDAP {
+		// LDAP users log in with their directory password
+		acc.IsImported = true
+		acc.password = ""
+	}
+
+	s.accounts[acc.ID] = acc
+	return acc
+}
+
+// deleteAccount the caller must hold the lock.
+func (s *Server) deleteAccount(acc *account) {
+	delete(s.accounts, acc.ID)
+	for _, org := range s.accounts {
+		if _, ok := org.members[acc.ID]; ok {
+			org.removeOrgMember(acc.ID)
+		}
+	}
+	for token, id := range s.tokens {
+		if id == acc.ID {
+			delete(s.tokens, token)
+		}
+	}
+}
+
+func (s *Server) handleAccounts(w http.ResponseWriter, r *http.Request, segs []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	caller := s.authenticate(w, r)
+	if caller == nil {
+		return
+	}
+
+	if len(segs) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			s.handleAccountList(w, r)
+		case http.MethodPost:
+			s.handleAccountCreate(w, r, caller)
+		default:
+			writeMethodNotAllowed(w, r)
+		}
+		return
+	}
+
+	acc := s.findAccount(segs[0])
+	if acc == nil {
+		writeError(w, http.StatusNotFound, ErrorCodeNotFound, fmt.Sprintf("no such account: %s", segs[0]))
+		return
+	}
+
+	switch {
+	case len(segs) == 1:
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, acc.ResponseAccount)
+		case http.MethodPatch:
+			s.handleAccountUpdate(w, r, caller, acc)
+		case http.MethodDelete:
+			if !caller.IsAdmin {
+				writeError(w, http.StatusForbidden, ErrorCodeNotAuthorized, "only admins can delete accounts")
+				return
+			}
+			s.deleteAccount(acc)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeMethodNotAllowed(w, r)
+		}
+	case len(segs) == 2 && segs[1] == "changePassword":
+		s.handleChangePassword(w, r, caller, acc)
+	case len(segs) == 2 && segs[1] == "totp":
+		s.handleResetOTP(w, r, caller, acc)
+	case segs[1] == "publicKeys":
+		s.handlePublicKeys(w, r, caller, acc, segs[2:])
+	case segs[1] == "teams":
+		s.handleTeams(w, r, caller, acc, segs[2:])
+	case segs[1] == "members":
+		s.handleOrgMembers(w, r, caller, acc, segs[2:])
+	case len(segs) == 2 && segs[1] == "organizations":
+		s.handleAccountOrgs(w, r, caller, acc)
+	default:
+		writeError(w, http.StatusNotFound, ErrorCodeNotFound, "unknown API target "+r.URL.Path)
+	}
+}
+
+func (s *Server) handleAccountList(w http.ResponseWriter, r *http.Request) {
+	filter := r.URL.Query().Get(QueryFilter)
+
+	res := client.ResponseAccounts{Accounts: []client.ResponseAccount{}}
+	matching := []client.ResponseAccount{}
+
+	for _, acc := range s.sortedAccounts() {
+		if acc.IsOrg {
+			res.OrgsCount++
+		} else {
+			res.UsersCount++
+		}
+
+		if accountMatchesFilter(acc.ResponseAccount, filter) {
+			matching = append(matching, acc.ResponseAccount)
+		}
+	}
+
+	page, next := paginate(r, len(matching), func(i int) string { return matching[i].Name })
+	res.Accounts = append(res.Accounts, matching[page[0]:page[1]]...)
+	res.NextPageStart = next
+	res.ResourceCount = len(matching)
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+func accountMatchesFilter(acc client.ResponseAccount, filter string) bool {
+	switch filter {
+	case "users":
+		return !acc.IsOrg
+	case "orgs":
+		return acc.IsOrg
+	case "admins":
+		return !acc.IsOrg && acc.IsAdmin
+	case "non-admins":
+		return !acc.IsOrg && !acc.IsAdmin
+	case "active-users":
+		return !acc.IsOrg && acc.IsActive
+	case "inactive-users":
+		return !acc.IsOrg && !acc.IsActive
+	}
+	return true
+}
+
+func (s *Server) handleAccountCreate(w http.ResponseWriter, r *http.Request, caller *account) {
+	if !caller.IsAdmin {
+		writeError(w, http.StatusForbidden, ErrorCodeNotAuthorized, "only admins can create accounts")
+		return
+	}
+
+	var f createAccountForm
+	if !readJSON(w, r, &f) {
+		return
+	}
+
+	if f.Name == "" {
+		writeError(w, http.StatusBadRequest, ErrorCodeInvalidForm, "an account name is required")
+		return
+	}
+	if s.findAccount(f.Name) != nil {
+		writeError(w, http.StatusConflict, ErrorCodeAlreadyExists, fmt.Sprintf("account %s already exists", f.Name))
+		return
+	}
+	if !f.IsOrg && !f.SearchLDAP && f.Password == "" {
+		writeError(w, http.StatusBadRequest, ErrorCodeInvalidForm, "a password is required for managed users")
+		return
+	}
+	if f.SearchLDAP {
+		if s.ldap.settings.ServerURL == "" {
+			writeError(w, http.StatusBadRequest, ErrorCodeInvalidForm, "LDAP is not configured")
+			return
+		}
+		if _, ok := s.ldap.users[f.Name]; !ok {
+			writeError(w, http.StatusBadRequest, ErrorCodeInvalidForm, fmt.Sprintf("no LDAP user found for %s", f.Name))
+			return
+		}
+	}
+
+	acc := s.createAccount(f)
+	writeJSON(w, http.StatusCreated, acc.ResponseAccount)
+}
+
+func (s *Server) handleAccountUpdate(w http.ResponseWriter, r *http.Request, caller, acc *account) {
+	if !caller.IsAdmin && caller.ID != acc.ID {
+		writeError(w, http.StatusForbidden, ErrorCodeNotAuthorized, "only admins can update other accounts")
+		return
+	}
+
+	var f updateAccountForm
+	if !readJSON(w, r, &f) {
+		return
+	}
+
+	if !caller.IsAdmin && (f.IsAdmin != nil || f.IsActive != nil) {
+		writeError(w, http.StatusForbidden, ErrorCodeNotAuthorized, "only admins can change admin or active flags")
+		return
+	}
+
+	if f.FullName != nil {
+		acc.FullName = *f.FullName
+	}
+	if !acc.IsOrg {
+		if f.IsActive != nil {
+			acc.IsActive = *f.IsActive
+		}
+		if f.IsAdmin != nil {
+			acc.IsAdmin = *f.IsAdmin
+		}
+	}
+
+	writeJSON(w, http.StatusOK, acc.ResponseAccount)
+}
+
+func (s *Server) handleResetOTP(w http.ResponseWriter, r *http.Request, caller, acc *account) {
+	if r.Method != http.MethodDelete {
+		writeMethodNotAllowed(w, r)
+		return
+	}
+	if !caller.IsAdmin && caller.ID != acc.ID {
+		writeError(w, http.StatusForbidden, ErrorCodeNotAuthorized, "only admins can reset the OTP of other accounts")
+		return
+	}
+
+	acc.OtpEnabled = false
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleChangePassword(w http.ResponseWriter, r *http.Request, caller, acc *account) {
+	if r.Method != http.MethodPost {
+		writeMethodNotAllowed(w, r)
+		return
+	}
+
+	var f client.ChangePassword
+	if !readJSON(w, r, &f) {
+		return
+	}
+
+	switch {
+	case !caller.IsAdmin && caller.ID != acc.ID:
+		writeError(w, http.StatusForbidden, ErrorCodeNotAuthorized, "only admins can reset the passwords of other accounts")
+		return
+	case acc.IsOrg || acc.IsImported:
+		writeError(w, http.StatusBadRequest, ErrorCodeInvalidForm, fmt.Sprintf("account %s does not have a managed password", acc.Name))
+		return
+	case f.NewPassword == "":
+		writeError(w, http.StatusBadRequest, ErrorCodeInvalidForm, "a new password is required")
+		return
+	case !caller.IsAdmin && f.OldPassword != acc.password:
+		writeError(w, http.StatusForbidden, ErrorCodeNotAuthorized, "the old password is not correct")
+		return
+	}
+
+	acc.password = f.NewPassword
+	acc.passwordChanges++
+	writeJSON(w, http.StatusOK, acc.ResponseAccount)
+}
+
+// paginate find the [from,to) page of a sorted listing using the start/limit query values,
+// where start is the key of the first item in the page. Also returns the key of the
+// first item of the next page, or "" if this is the last page.
+func paginate(r *http.Request, count int, key func(int) string) ([2]int, string) {
+	q := r.URL.Query()
+
+	limit := DefaultPageLimit
+	if l, err := strconv.Atoi(q.Get(QueryLimit)); err == nil && l > 0 {
+		limit = l
+	}
+
+	from := 0
+	if start := q.Get(QueryStart); start != "" {
+		for from < count && key(from) < start {
+			from++
+		}
+	}
+
+	to := from + limit
+	if to >= count {
+		return [2]int{from, count}, ""
+	}
+	return [2]int{from, to}, key(to)
+}
+
+// newID an account style UUID.
+func newID() string {
+	h := randomHex(16)
+	return fmt.Sprintf("%s-%s-%s-%s-%s", h[0:8], h[8:12], h[12:16], h[16:20], h[20:32])
+}
@@ -0,0 +1,369 @@
+package mketest
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+const (
+	// FakeKubePort port used for the kubernetes API in generated bundles.
+	FakeKubePort = "6443"
+)
+
+// certificateAuthority the fake cluster CA which signs client bundle certificates.
+type certificateAuthority struct {
+	key     *ecdsa.PrivateKey
+	cert    *x509.Certificate
+	certPEM string
+}
+
+// issuedBundle a freshly generated client bundle key pair.
+type issuedBundle struct {
+	keyID   string
+	keyPEM  string
+	certPEM string
+	pubPEM  string
+}
+
+func newCertificateAuthority() certificateAuthority {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(fmt.Errorf("fake MKE could not generate a CA key: %w", err))
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "MKE fake cluster CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		panic(fmt.Errorf("fake MKE could not sign the CA certificate: %w", err))
+	}
+	cert, _ := x509.ParseCertificate(der)
+
+	return certificateAuthority{
+		key:     key,
+		cert:    cert,
+		certPEM: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+	}
+}
+
+// issue generate a key pair and a client certificate for the account.
+func (ca certificateAuthority) issue(username string) (issuedBundle, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return issuedBundle{}, err
+	}
+
+	serial, _ := rand.Int(rand.Reader, big.NewInt(1<<62))
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: username},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		return issuedBundle{}, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return issuedBundle{}, err
+	}
+	pubDER, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return issuedBundle{}, err
+	}
+
+	keyID := sha256.Sum256(pubDER)
+
+	return issuedBundle{
+		keyID:   hex.EncodeToString(keyID[:]),
+		keyPEM:  string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+		certPEM: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})),
+		pubPEM:  string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER})),
+	}, nil
+}
+
+func (s *Server) handleClientBundle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeMethodNotAllowed(w, r)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	caller := s.authenticate(w, r)
+	if caller == nil {
+		return
+	}
+
+	ib, err := s.ca.issue(caller.Name)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+
+	label := r.URL.Query().Get(client.URLTargetForClientBundleQueryLabel)
+	caller.publicKeys = append(caller.publicKeys, client.AccountPublicKey{
+		ID:        ib.keyID,
+		AccountID: caller.ID,
+		PublicKey: ib.pubPEM,
+		Label:     label,
+	})
+
+	zb, err := s.bundleZip(caller.Name, label, ib)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Write(zb) //nolint:errcheck
+}
+
+// bundleZip build a client bundle zip in the same layout as MKE.
+func (s *Server) bundleZip(username, label string, ib issuedBundle) ([]byte, error) {
+	u, _ := url.Parse(s.testServer.URL)
+	host := u.Hostname()
+	name := fmt.Sprintf("ucp_%s_%s_%s", host, username, ib.keyID[:12])
+
+	kubeHost := fmt.Sprintf("https://%s:%s", host, FakeKubePort)
+	dockerHost := fmt.Sprintf("tcp://%s", u.Host)
+
+	meta, err := json.Marshal(map[string]interface{}{
+		"Name": name,
+		"Metadata": map[string]string{
+			"Description":       label,
+			"StackOrchestrator": "kubernetes",
+		},
+		"Endpoints": map[string]interface{}{
+			client.ClientBundleMetaEndpointDocker:     map[string]interface{}{"Host": dockerHost, "SkipTLSVerify": false},
+			client.ClientBundleMetaEndpointKubernetes: map[string]interface{}{"Host": kubeHost, "SkipTLSVerify": false},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dockerZip, err := zipFiles(map[string][]byte{"meta.json": meta}, "")
+	if err != nil {
+		return nil, err
+	}
+
+	b64 := func(v string) string { return base64.StdEncoding.EncodeToString([]byte(v)) }
+	kube := fmt.Sprintf(`apiVersion: v1
+kind: Config
+preferences: {}
+clusters:
+- name: %[1]s_cluster
+  cluster:
+    certificate-authority-data: %[2]s
+    server: %[3]s
+contexts:
+- name: %[1]s
+  context:
+    cluster: %[1]s_cluster
+    user: %[1]s_user
+current-context: %[1]s
+users:
+- name: %[1]s_user
+  user:
+    client-certificate-data: %[4]s
+    client-key-data: %[5]s
+`, name, b64(s.ca.certPEM), kubeHost, b64(ib.certPEM), b64(ib.keyPEM))
+
+	return zipFiles(map[string][]byte{
+		"ca.pem":                []byte(s.ca.certPEM),
+		"cert.pem":              []byte(ib.certPEM),
+		"key.pem":               []byte(ib.keyPEM),
+		"cert.pub":              []byte(ib.pubPEM),
+		"kube.yml":              []byte(kube),
+		"env.sh":                []byte(fmt.Sprintf("export DOCKER_HOST=%s\n", dockerHost)),
+		"ucp-docker-bundle.zip": dockerZip,
+	}, name)
+}
+
+// zipFiles build a zip archive with a deterministic file order.
+func zipFiles(files map[string][]byte, comment string) ([]byte, error) {
+	names := make([]string, 0, len(files))
+	for n := range files {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	for _, n := range names {
+		fw, err := zw.Create(n)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := fw.Write(files[n]); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := zw.SetComment(comment); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (s *Server) handlePublicKeys(w http.ResponseWriter, r *http.Request, caller, acc *account, segs []string) {
+	if !caller.IsAdmin && caller.ID != acc.ID {
+		writeError(w, http.StatusForbidden, ErrorCodeNotAuthorized, "only admins can manage keys of other accounts")
+		return
+	}
+
+	if len(segs) == 0 {
+		switch r.Method {
+		case http.MethodPost:
+			s.handlePublicKeyCreate(w, r, acc)
+		case http.MethodGet:
+			keys := append([]client.AccountPublicKey{}, acc.publicKeys...)
+			sort.Slice(keys, func(i, j int) bool { return keys[i].ID < keys[j].ID })
+
+			page, next := paginate(r, len(keys), func(i int) string { return keys[i].ID })
+			writeJSON(w, http.StatusOK, client.GetKeysResponse{
+				AccountPubKeys: append([]client.AccountPublicKey{}, keys[page[0]:page[1]]...),
+				NextPageStart:  next,
+			})
+		default:
+			writeMethodNotAllowed(w, r)
+		}
+		return
+	}
+
+	if len(segs) != 1 {
+		writeError(w, http.StatusNotFound, ErrorCodeNotFound, "unknown API target "+r.URL.Path)
+		return
+	}
+
+	i := -1
+	for j, k := range acc.publicKeys {
+		if k.ID == segs[0] {
+			i = j
+			break
+		}
+	}
+	if i < 0 {
+		writeError(w, http.StatusNotFound, ErrorCodeNotFound, fmt.Sprintf("no such public key: %s", segs[0]))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, acc.publicKeys[i])
+	case http.MethodPatch:
+		var f client.UpdatePublicKey
+		if !readJSON(w, r, &f) {
+			return
+		}
+		acc.publicKeys[i].Label = f.Label
+		writeJSON(w, http.StatusOK, acc.publicKeys[i])
+	case http.MethodDelete:
+		acc.publicKeys = append(acc.publicKeys[:i], acc.publicKeys[i+1:]...)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+// IssueKeyPair generate a public key and a certificate for it from the cluster CA, as if they were made outside
+// of MKE, e.g. in an HSM, so that tests can upload them.
+func (s *Server) IssueKeyPair(username string) (publicKeyPEM, certPEM string, err error) {
+	ib, err := s.ca.issue(username)
+	if err != nil {
+		return "", "", err
+	}
+	return ib.pubPEM, ib.certPEM, nil
+}
+
+// handlePublicKeyCreate upload a public key, whose ID is the hash of its DER bytes.
+// Certificates must be for the uploaded key.
+func (s *Server) handlePublicKeyCreate(w http.ResponseWriter, r *http.Request, acc *account) {
+	var f client.CreatePublicKey
+	if !readJSON(w, r, &f) {
+		return
+	}
+
+	block, _ := pem.Decode([]byte(f.PublicKey))
+	if block == nil || block.Type != "PUBLIC KEY" {
+		writeError(w, http.StatusBadRequest, ErrorCodeInvalidForm, "publicKey must be a PEM encoded PUBLIC KEY")
+		return
+	}
+	if _, err := x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+		writeError(w, http.StatusBadRequest, ErrorCodeInvalidForm, fmt.Sprintf("invalid public key: %s", err))
+		return
+	}
+
+	for _, c := range f.Certificates {
+		certBlock, _ := pem.Decode([]byte(c.Cert))
+		if certBlock == nil || certBlock.Type != "CERTIFICATE" {
+			writeError(w, http.StatusBadRequest, ErrorCodeInvalidForm, fmt.Sprintf("certificate %q must be a PEM encoded CERTIFICATE", c.Label))
+			return
+		}
+		cert, err := x509.ParseCertificate(certBlock.Bytes)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, ErrorCodeInvalidForm, fmt.Sprintf("invalid certificate %q: %s", c.Label, err))
+			return
+		}
+		if !bytes.Equal(cert.RawSubjectPublicKeyInfo, block.Bytes) {
+			writeError(w, http.StatusBadRequest, ErrorCodeInvalidForm, fmt.Sprintf("certificate %q is not for the public key", c.Label))
+			return
+		}
+	}
+
+	sum := sha256.Sum256(block.Bytes)
+	id := hex.EncodeToString(sum[:])
+	for _, k := range acc.publicKeys {
+		if k.ID == id {
+			writeError(w, http.StatusConflict, ErrorCodeAlreadyExists, fmt.Sprintf("public key %s already exists", id))
+			return
+		}
+	}
+
+	key := client.AccountPublicKey{
+		ID:           id,
+		AccountID:    acc.ID,
+		PublicKey:    f.PublicKey,
+		Label:        f.Label,
+		Certificates: f.Certificates,
+	}
+	acc.publicKeys = append(acc.publicKeys, key)
+	writeJSON(w, http.StatusCreated, key)
+}
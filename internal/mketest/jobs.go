@@ -0,0 +1,173 @@
+package mketest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+// job server side job state.
+// Jobs move from waiting, to running, to their result, one step each time they are read,
+// so that clients have to poll them.
+type job struct {
+	client.ResponseJob
+
+	// result the status the job finishes with.
+	result string
+	logs   []client.JobLogLine
+}
+
+// Jobs the jobs for an action, newest first.
+func (s *Server) Jobs(action string) []client.ResponseJob {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	jobs := []client.ResponseJob{}
+	for i := len(s.jobs) - 1; i >= 0; i-- {
+		if s.jobs[i].Action == action {
+			jobs = append(jobs, s.jobs[i].ResponseJob)
+		}
+	}
+	return jobs
+}
+
+// findJob the caller must hold the lock.
+func (s *Server) findJob(id string) *job {
+	for _, j := range s.jobs {
+		if j.ID == id {
+			return j
+		}
+	}
+	return nil
+}
+
+// advance move the job on to its next status. The caller must hold the lock.
+func (j *job) advance() {
+	switch j.Status {
+	case client.JobStatusWaiting:
+		j.Status = client.JobStatusRunning
+	case client.JobStatusRunning:
+		j.Status = j.result
+	default:
+		return
+	}
+	j.LastUpdated = time.Now().UTC().Format(time.RFC3339)
+}
+
+// newLDAPSyncJob a sync job, with its result decided by the current LDAP settings. The caller must hold the lock.
+func (s *Server) newLDAPSyncJob() *job {
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	j := &job{
+		ResponseJob: client.ResponseJob{
+			ID:          newID(),
+			Action:      client.JobActionLDAPSync,
+			WorkerID:    "worker-0",
+			Status:      client.JobStatusWaiting,
+			ScheduledAt: now,
+			LastUpdated: now,
+		},
+		result: client.JobStatusDone,
+	}
+
+	log := func(level, msg string) {
+		j.logs = append(j.logs, client.JobLogLine{Level: level, Msg: msg, Time: now})
+	}
+
+	switch {
+	case s.ldap.settings.ServerURL == "":
+		j.result = client.JobStatusErrored
+		log(client.JobLogLevelError, "LDAP is not configured")
+	case len(s.ldap.settings.UserSearchConfigs) == 0:
+		log(client.JobLogLevelWarning, "no user search configs, so no users were synced")
+	default:
+		log(client.JobLogLevelInfo, fmt.Sprintf("connected to %s", s.ldap.settings.ServerURL))
+		log(client.JobLogLevelInfo, fmt.Sprintf("synced %d users", len(s.ldap.users)))
+	}
+
+	return j
+}
+
+func (s *Server) handleJobs(w http.ResponseWriter, r *http.Request, segs []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	caller := s.authenticate(w, r)
+	if caller == nil {
+		return
+	}
+	if !caller.IsAdmin {
+		writeError(w, http.StatusForbidden, ErrorCodeNotAuthorized, "only admins can manage jobs")
+		return
+	}
+
+	if len(segs) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			s.handleJobList(w, r)
+		case http.MethodPost:
+			var f client.CreateJob
+			if !readJSON(w, r, &f) {
+				return
+			}
+			if f.Action != client.JobActionLDAPSync {
+				writeError(w, http.StatusBadRequest, ErrorCodeInvalidForm, fmt.Sprintf("unsupported job action: %s", f.Action))
+				return
+			}
+
+			j := s.newLDAPSyncJob()
+			s.jobs = append(s.jobs, j)
+			writeJSON(w, http.StatusAccepted, j.ResponseJob)
+		default:
+			writeMethodNotAllowed(w, r)
+		}
+		return
+	}
+
+	j := s.findJob(segs[0])
+	if j == nil {
+		writeError(w, http.StatusNotFound, ErrorCodeNotFound, fmt.Sprintf("no such job: %s", segs[0]))
+		return
+	}
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w, r)
+		return
+	}
+
+	switch {
+	case len(segs) == 1:
+		j.advance()
+		writeJSON(w, http.StatusOK, j.ResponseJob)
+	case len(segs) == 2 && segs[1] == "logs":
+		// logs are only written once the job has run
+		res := client.ResponseJobLogs{Lines: []client.JobLogLine{}}
+		if j.Finished() {
+			res.Lines = append(res.Lines, j.logs...)
+		}
+		writeJSON(w, http.StatusOK, res)
+	default:
+		writeError(w, http.StatusNotFound, ErrorCodeNotFound, "unknown API target "+r.URL.Path)
+	}
+}
+
+func (s *Server) handleJobList(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	action := q.Get(client.URLQueryJobAction)
+
+	limit := DefaultPageLimit
+	if l, err := strconv.Atoi(q.Get(QueryLimit)); err == nil && l > 0 {
+		limit = l
+	}
+
+	res := client.ResponseJobs{Jobs: []client.ResponseJob{}}
+	for i := len(s.jobs) - 1; i >= 0 && len(res.Jobs) < limit; i-- {
+		if action == "" || s.jobs[i].Action == action {
+			res.Jobs = append(res.Jobs, s.jobs[i].ResponseJob)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
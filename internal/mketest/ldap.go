@@ -0,0 +1,132 @@
+package mketest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+// DefaultLDAPSyncSchedule the sync schedule of a fresh cluster.
+const DefaultLDAPSyncSchedule = "@hourly"
+
+// ldapDirectory server side LDAP state: the eNZi settings, and the users in the fake directory.
+type ldapDirectory struct {
+	settings       client.LDAPSettings
+	readerPassword string
+	// users usernames to passwords.
+	users map[string]string
+}
+
+func newLDAPDirectory() ldapDirectory {
+	return ldapDirectory{
+		settings: client.LDAPSettings{
+			UserSearchConfigs: []client.LDAPUserSearchConfig{},
+			SyncSchedule:      DefaultLDAPSyncSchedule,
+		},
+		users: map[string]string{},
+	}
+}
+
+// LDAPSettings the current LDAP settings, and the stored reader password.
+func (s *Server) LDAPSettings() (client.LDAPSettings, string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.ldap.settings, s.ldap.readerPassword
+}
+
+// AddLDAPUser add a user to the fake LDAP directory, which is used for LDAP logins and LDAP user lookups.
+func (s *Server) AddLDAPUser(username, password string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ldap.users[username] = password
+}
+
+func (s *Server) handleLDAPSettings(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	caller := s.authenticate(w, r)
+	if caller == nil {
+		return
+	}
+	if !caller.IsAdmin {
+		writeError(w, http.StatusForbidden, ErrorCodeNotAuthorized, "only admins can manage LDAP settings")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.ldap.settings)
+	case http.MethodPut:
+		var settings client.LDAPSettings
+		if !readJSON(w, r, &settings) {
+			return
+		}
+		if settings.ServerURL == "" {
+			writeError(w, http.StatusBadRequest, ErrorCodeInvalidForm, "an LDAP server URL is required")
+			return
+		}
+
+		if settings.ReaderPassword != "" {
+			s.ldap.readerPassword = settings.ReaderPassword
+		}
+		settings.ReaderPassword = ""
+		if settings.UserSearchConfigs == nil {
+			settings.UserSearchConfigs = []client.LDAPUserSearchConfig{}
+		}
+		s.ldap.settings = settings
+
+		writeJSON(w, http.StatusOK, s.ldap.settings)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) handleLDAPTryLogin(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeMethodNotAllowed(w, r)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	caller := s.authenticate(w, r)
+	if caller == nil {
+		return
+	}
+	if !caller.IsAdmin {
+		writeError(w, http.StatusForbidden, ErrorCodeNotAuthorized, "only admins can test LDAP settings")
+		return
+	}
+
+	var try client.LDAPTryLogin
+	if !readJSON(w, r, &try) {
+		return
+	}
+
+	res := client.LDAPTryLoginResponse{Logs: []string{fmt.Sprintf("connecting to %s", try.LDAPSettings.ServerURL)}}
+
+	readerPassword := try.LDAPSettings.ReaderPassword
+	if readerPassword == "" {
+		readerPassword = s.ldap.readerPassword
+	}
+
+	switch {
+	case try.LDAPSettings.ServerURL == "":
+		res.ErrorMessage = "no LDAP server URL"
+	case try.LDAPSettings.ReaderDN != "" && readerPassword == "":
+		res.ErrorMessage = fmt.Sprintf("unable to bind as %s", try.LDAPSettings.ReaderDN)
+	case len(try.LDAPSettings.UserSearchConfigs) == 0:
+		res.ErrorMessage = "no user search configs"
+	case s.ldap.users[try.Username] == "" || s.ldap.users[try.Username] != try.Password:
+		res.ErrorMessage = fmt.Sprintf("invalid credentials for %s", try.Username)
+	default:
+		res.Logs = append(res.Logs, fmt.Sprintf("found user %s", try.Username))
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
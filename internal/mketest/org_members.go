@@ -0,0 +1,199 @@
+package mketest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+// OrgMembers the current members of an org, by account name to whether they are org admins.
+func (s *Server) OrgMembers(org string) (map[string]bool, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	acc := s.findAccount(org)
+	if acc == nil || !acc.IsOrg {
+		return nil, false
+	}
+
+	members := map[string]bool{}
+	for _, m := range s.sortedMembers(acc.members) {
+		members[m.Member.Name] = m.IsAdmin
+	}
+	return members, true
+}
+
+// SetOrgMember add an account to an org directly in the server state, as if it was done outside of terraform.
+func (s *Server) SetOrgMember(org, member string, isAdmin bool) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o := s.findAccount(org)
+	acc := s.findAccount(member)
+	if o == nil || !o.IsOrg || acc == nil || acc.IsOrg {
+		return false
+	}
+	o.setOrgMember(acc.ID, isAdmin)
+	return true
+}
+
+// RemoveOrgMember remove an account from an org and all of its teams directly in the server state,
+// as if it was done outside of terraform.
+func (s *Server) RemoveOrgMember(org, member string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o := s.findAccount(org)
+	acc := s.findAccount(member)
+	if o == nil || acc == nil {
+		return false
+	}
+	if _, ok := o.members[acc.ID]; !ok {
+		return false
+	}
+	o.removeOrgMember(acc.ID)
+	return true
+}
+
+// setOrgMember the caller must hold the lock.
+func (org *account) setOrgMember(accountID string, isAdmin bool) {
+	if org.members == nil {
+		org.members = map[string]bool{}
+	}
+	org.members[accountID] = isAdmin
+	org.MembersCount = len(org.members)
+}
+
+// addImplicitOrgMember make an account an org member, as eNZi does when it is added to one of the
+// org teams. Existing memberships are left unchanged. The caller must hold the lock.
+func (org *account) addImplicitOrgMember(accountID string) {
+	if _, ok := org.members[accountID]; !ok {
+		org.setOrgMember(accountID, false)
+	}
+}
+
+// removeOrgMember remove an account from the org and all of its teams. The caller must hold the lock.
+func (org *account) removeOrgMember(accountID string) {
+	delete(org.members, accountID)
+	org.MembersCount = len(org.members)
+	for _, t := range org.teams {
+		t.removeMember(accountID)
+	}
+}
+
+func (s *Server) handleAccountOrgs(w http.ResponseWriter, r *http.Request, caller, acc *account) {
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w, r)
+		return
+	}
+	if !caller.IsAdmin && caller.ID != acc.ID {
+		writeError(w, http.StatusForbidden, ErrorCodeNotAuthorized, "only admins can list the orgs of other accounts")
+		return
+	}
+
+	orgs := []client.ResponseMemberOrg{}
+	for _, o := range s.sortedAccounts() {
+		if isAdmin, ok := o.members[acc.ID]; o.IsOrg && ok {
+			orgs = append(orgs, client.ResponseMemberOrg{Org: o.ResponseAccount, IsAdmin: isAdmin})
+		}
+	}
+	page, next := paginate(r, len(orgs), func(i int) string { return orgs[i].Org.Name })
+
+	writeJSON(w, http.StatusOK, client.ResponseMemberOrgs{
+		MemberOrgs:    append([]client.ResponseMemberOrg{}, orgs[page[0]:page[1]]...),
+		NextPageStart: next,
+	})
+}
+
+func (s *Server) handleOrgMembers(w http.ResponseWriter, r *http.Request, caller, org *account, segs []string) {
+	if !org.IsOrg {
+		writeError(w, http.StatusNotFound, ErrorCodeNotFound, fmt.Sprintf("account %s is not an organization", org.Name))
+		return
+	}
+
+	if len(segs) == 0 {
+		if r.Method != http.MethodGet {
+			writeMethodNotAllowed(w, r)
+			return
+		}
+
+		members := s.sortedMembers(org.members)
+		page, next := paginate(r, len(members), func(i int) string { return members[i].Member.Name })
+
+		writeJSON(w, http.StatusOK, client.ResponseMembers{
+			Members:       append([]client.ResponseMember{}, members[page[0]:page[1]]...),
+			NextPageStart: next,
+		})
+		return
+	}
+
+	acc := s.findAccount(segs[0])
+	if acc == nil || acc.IsOrg {
+		writeError(w, http.StatusNotFound, ErrorCodeNotFound, fmt.Sprintf("no such user: %s", segs[0]))
+		return
+	}
+
+	isAdmin, isMember := org.members[acc.ID]
+
+	if len(segs) == 2 && segs[1] == "teams" && r.Method == http.MethodGet {
+		if !isMember {
+			writeError(w, http.StatusNotFound, ErrorCodeNotFound, fmt.Sprintf("%s is not a member of org %s", acc.Name, org.Name))
+			return
+		}
+
+		teams := []*team{}
+		for _, t := range org.sortedTeams() {
+			if _, ok := t.members[acc.ID]; ok {
+				teams = append(teams, t)
+			}
+		}
+		page, next := paginate(r, len(teams), func(i int) string { return teams[i].Name })
+
+		res := responseTeams{Teams: []client.ResponseTeam{}, NextPageStart: next}
+		for _, t := range teams[page[0]:page[1]] {
+			res.Teams = append(res.Teams, t.ResponseTeam)
+		}
+		writeJSON(w, http.StatusOK, res)
+		return
+	}
+
+	if len(segs) > 1 {
+		writeError(w, http.StatusNotFound, ErrorCodeNotFound, "unknown API target "+r.URL.Path)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if !isMember {
+			writeError(w, http.StatusNotFound, ErrorCodeNotFound, fmt.Sprintf("%s is not a member of org %s", acc.Name, org.Name))
+			return
+		}
+		writeJSON(w, http.StatusOK, client.ResponseMember{Member: acc.ResponseAccount, IsAdmin: isAdmin})
+	case http.MethodPut:
+		if !caller.IsAdmin {
+			writeError(w, http.StatusForbidden, ErrorCodeNotAuthorized, "only admins can change org members")
+			return
+		}
+
+		var f client.MemberForm
+		if !readJSON(w, r, &f) {
+			return
+		}
+		org.setOrgMember(acc.ID, f.IsAdmin)
+		writeJSON(w, http.StatusOK, client.ResponseMember{Member: acc.ResponseAccount, IsAdmin: f.IsAdmin})
+	case http.MethodDelete:
+		if !caller.IsAdmin {
+			writeError(w, http.StatusForbidden, ErrorCodeNotAuthorized, "only admins can change org members")
+			return
+		}
+		if !isMember {
+			writeError(w, http.StatusNotFound, ErrorCodeNotFound, fmt.Sprintf("%s is not a member of org %s", acc.Name, org.Name))
+			return
+		}
+		org.removeOrgMember(acc.ID)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
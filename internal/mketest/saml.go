@@ -0,0 +1,92 @@
+package mketest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+// SAMLSettings the current SAML settings.
+func (s *Server) SAMLSettings() client.SAMLSettings {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.saml
+}
+
+// SAMLMetadata the service provider metadata XML which the server would give to the IdP.
+func (s *Server) SAMLMetadata() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return samlMetadata(s.saml.SPHost)
+}
+
+func samlMetadata(spHost string) string {
+	return fmt.Sprintf(`<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata" entityID="%[1]s/enzi/v0/saml/metadata">`+
+		`<SPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">`+
+		`<AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="%[1]s/enzi/v0/saml/acs" index="1"></AssertionConsumerService>`+
+		`</SPSSODescriptor></EntityDescriptor>`, spHost)
+}
+
+func (s *Server) handleSAMLSettings(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	caller := s.authenticate(w, r)
+	if caller == nil {
+		return
+	}
+	if !caller.IsAdmin {
+		writeError(w, http.StatusForbidden, ErrorCodeNotAuthorized, "only admins can manage SAML settings")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.saml)
+	case http.MethodPut:
+		var settings client.SAMLSettings
+		if !readJSON(w, r, &settings) {
+			return
+		}
+		if settings.Enabled && (settings.IdPMetadataURL == "" || settings.SPHost == "") {
+			writeError(w, http.StatusBadRequest, ErrorCodeInvalidForm, "an IdP metadata URL and service provider host are required to enable SAML")
+			return
+		}
+		for _, m := range settings.TeamMappings {
+			if m.AttributeName == "" || m.AttributeValue == "" || m.Org == "" {
+				writeError(w, http.StatusBadRequest, ErrorCodeInvalidForm, "team mappings need an attribute name, attribute value and org")
+				return
+			}
+		}
+
+		if settings.TeamMappings == nil {
+			settings.TeamMappings = []client.SAMLTeamMapping{}
+		}
+		s.saml = settings
+
+		writeJSON(w, http.StatusOK, s.saml)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) handleSAMLMetadata(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w, r)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.saml.SPHost == "" {
+		writeError(w, http.StatusNotFound, ErrorCodeNotFound, "SAML service provider host is not configured")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.Write([]byte(samlMetadata(s.saml.SPHost))) //nolint:errcheck
+}
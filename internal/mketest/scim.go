@@ -0,0 +1,143 @@
+package mketest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+// scimState server side SCIM state: the eNZi settings, and the issued tokens by ID.
+type scimState struct {
+	settings client.SCIMSettings
+	tokens   map[string]client.ResponseSCIMToken
+}
+
+// SCIMSettings the current SCIM settings.
+func (s *Server) SCIMSettings() client.SCIMSettings {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.scim.settings
+}
+
+// SCIMTokens the SCIM tokens which have not been revoked, sorted by ID, including their token values.
+func (s *Server) SCIMTokens() []client.ResponseSCIMToken {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.sortedSCIMTokens()
+}
+
+// RevokeSCIMToken revoke a SCIM token, as if it was revoked outside of terraform.
+func (s *Server) RevokeSCIMToken(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.scim.tokens[id]; !ok {
+		return false
+	}
+	delete(s.scim.tokens, id)
+	return true
+}
+
+// sortedSCIMTokens the caller must hold the lock.
+func (s *Server) sortedSCIMTokens() []client.ResponseSCIMToken {
+	tokens := make([]client.ResponseSCIMToken, 0, len(s.scim.tokens))
+	for _, t := range s.scim.tokens {
+		tokens = append(tokens, t)
+	}
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].ID < tokens[j].ID })
+	return tokens
+}
+
+func (s *Server) handleSCIM(w http.ResponseWriter, r *http.Request, segs []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	caller := s.authenticate(w, r)
+	if caller == nil {
+		return
+	}
+	if !caller.IsAdmin {
+		writeError(w, http.StatusForbidden, ErrorCodeNotAuthorized, "only admins can manage SCIM")
+		return
+	}
+
+	switch {
+	case len(segs) == 0:
+		s.handleSCIMSettings(w, r)
+	case segs[0] == "tokens" && len(segs) == 1:
+		s.handleSCIMTokens(w, r)
+	case segs[0] == "tokens" && len(segs) == 2:
+		s.handleSCIMToken(w, r, segs[1])
+	default:
+		writeError(w, http.StatusNotFound, ErrorCodeNotFound, "unknown API target "+r.URL.Path)
+	}
+}
+
+func (s *Server) handleSCIMSettings(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.scim.settings)
+	case http.MethodPut:
+		var settings client.SCIMSettings
+		if !readJSON(w, r, &settings) {
+			return
+		}
+		s.scim.settings = settings
+		writeJSON(w, http.StatusOK, s.scim.settings)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) handleSCIMTokens(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		res := client.ResponseSCIMTokens{Tokens: []client.ResponseSCIMToken{}}
+		for _, t := range s.sortedSCIMTokens() {
+			t.Token = ""
+			res.Tokens = append(res.Tokens, t)
+		}
+		writeJSON(w, http.StatusOK, res)
+	case http.MethodPost:
+		var f client.CreateSCIMToken
+		if !readJSON(w, r, &f) {
+			return
+		}
+
+		t := client.ResponseSCIMToken{
+			ID:          newID(),
+			Description: f.Description,
+			CreatedAt:   time.Now().UTC().Format(time.RFC3339),
+			Token:       randomHex(32),
+		}
+		s.scim.tokens[t.ID] = t
+
+		writeJSON(w, http.StatusCreated, t)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) handleSCIMToken(w http.ResponseWriter, r *http.Request, id string) {
+	t, ok := s.scim.tokens[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, ErrorCodeNotFound, fmt.Sprintf("no such SCIM token: %s", id))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		t.Token = ""
+		writeJSON(w, http.StatusOK, t)
+	case http.MethodDelete:
+		delete(s.scim.tokens, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
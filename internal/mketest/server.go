@@ -0,0 +1,276 @@
+package mketest
+
+/**
+An in-memory fake of the MKE API, for use as a real endpoint in tests.
+
+This grew out of the MockTestServer used in the client tests, but instead of
+canned responses per path, it keeps state: accounts, their passwords and public
+keys, org members, org teams with their members and LDAP sync settings, login
+tokens, issued client bundles, LDAP settings with a small fake directory, SAML
+settings, SCIM settings with their tokens, and LDAP sync jobs. This lets client
+and provider tests exercise whole create/read/update/import/delete flows,
+including objects which are changed or removed behind terraform's back.
+
+  e.g.
+
+  ```
+	s := mketest.NewServer()
+	defer s.Close()
+
+	c, _ := s.Client() // client logged in as the default admin
+
+	s.RemoveAccount("someuser") // simulate a change made outside of terraform
+  ```
+
+Only the parts of the eNZi/MKE API which the client uses are implemented.
+Errors are returned in the eNZi error format.
+*/
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+const (
+	// DefaultAdminUsername the admin account which every fake server starts with.
+	DefaultAdminUsername = "admin"
+	// DefaultAdminPassword the password of the default admin account.
+	DefaultAdminPassword = "adminpassword"
+
+	ErrorCodeNotFound        = "NOT_FOUND"
+	ErrorCodeInvalidJSON     = "INVALID_JSON"
+	ErrorCodeInvalidForm     = "INVALID_FORM"
+	ErrorCodeAlreadyExists   = "ALREADY_EXISTS"
+	ErrorCodeUnauthenticated = "UNAUTHENTICATED"
+	ErrorCodeNotAuthorized   = "NOT_AUTHORIZED"
+	ErrorCodeMethodNotFound  = "METHOD_NOT_ALLOWED"
+)
+
+// Server a stateful in-memory fake MKE API server.
+type Server struct {
+	mu sync.Mutex
+
+	testServer *httptest.Server
+	ca         certificateAuthority
+
+	// accounts by ID.
+	accounts map[string]*account
+	// tokens to the ID of the account that logged in.
+	tokens map[string]string
+	// ldap settings and directory.
+	ldap ldapDirectory
+	// saml settings.
+	saml client.SAMLSettings
+	// scim settings and tokens.
+	scim scimState
+	// jobs oldest first.
+	jobs []*job
+}
+
+// account server side account state.
+type account struct {
+	client.ResponseAccount
+
+	password string
+	// passwordChanges count of the password changes made through the API.
+	passwordChanges int
+	publicKeys      []client.AccountPublicKey
+	// teams of an org account, by ID.
+	teams map[string]*team
+	// members of an org account, account IDs to whether they are org admins.
+	members map[string]bool
+}
+
+// apiErrors eNZi error response body.
+type apiErrors struct {
+	Errors []apiError `json:"errors"`
+}
+
+type apiError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// NewServer start a fake MKE server with a single admin account.
+func NewServer() *Server {
+	s := &Server{
+		ca:       newCertificateAuthority(),
+		accounts: map[string]*account{},
+		tokens:   map[string]string{},
+		ldap:     newLDAPDirectory(),
+		saml:     client.SAMLSettings{TeamMappings: []client.SAMLTeamMapping{}},
+		scim:     scimState{tokens: map[string]client.ResponseSCIMToken{}},
+	}
+
+	s.CreateAccount(client.CreateAccount{
+		Name:     DefaultAdminUsername,
+		Password: DefaultAdminPassword,
+		FullName: "Default admin",
+		IsAdmin:  true,
+		IsActive: true,
+	})
+
+	s.testServer = httptest.NewServer(http.HandlerFunc(s.handle))
+
+	return s
+}
+
+// Close the fake server.
+func (s *Server) Close() {
+	s.testServer.Close()
+}
+
+// URL the endpoint of the fake server.
+func (s *Server) URL() string {
+	return s.testServer.URL
+}
+
+// Client an MKE client for the fake server, using the default admin account.
+func (s *Server) Client() (client.Client, error) {
+	return s.ClientFor(DefaultAdminUsername, DefaultAdminPassword)
+}
+
+// ClientFor an MKE client for the fake server, using the passed credentials.
+func (s *Server) ClientFor(username, password string) (client.Client, error) {
+	u, _ := url.Parse(s.testServer.URL)
+	auth := client.NewAuthUP(username, password)
+	return client.NewClient(u, &auth, s.testServer.Client())
+}
+
+// handle route a request to its handler.
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	segs := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+
+	switch {
+	case pathIs(segs, "_ping"):
+		s.handlePing(w, r)
+	case pathIs(segs, "auth", "login"):
+		s.handleLogin(w, r)
+	case pathIs(segs, "api", "clientbundle"):
+		s.handleClientBundle(w, r)
+	case pathIs(segs, "config", "auth", "ldap"):
+		s.handleLDAPSettings(w, r)
+	case pathIs(segs, "config", "auth", "ldap", "tryLogin"):
+		s.handleLDAPTryLogin(w, r)
+	case pathIs(segs, "config", "auth", "saml"):
+		s.handleSAMLSettings(w, r)
+	case pathIs(segs, "saml", "metadata"):
+		s.handleSAMLMetadata(w, r)
+	case len(segs) >= 3 && pathIs(segs[:3], "config", "auth", "scim"):
+		s.handleSCIM(w, r, segs[3:])
+	case segs[0] == client.URLTargetForJobs:
+		s.handleJobs(w, r, segs[1:])
+	case segs[0] == client.URLTargetForAccounts:
+		s.handleAccounts(w, r, segs[1:])
+	default:
+		writeError(w, http.StatusNotFound, ErrorCodeNotFound, "unknown API target "+r.URL.Path)
+	}
+}
+
+func (s *Server) handlePing(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w, r)
+		return
+	}
+	w.Write([]byte("OK")) //nolint:errcheck
+}
+
+func (s *Server) handleLogin(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeMethodNotAllowed(w, r)
+		return
+	}
+
+	var auth client.Auth
+	if !readJSON(w, r, &auth) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	acc := s.findAccount(auth.Username)
+	if acc == nil || acc.IsOrg || !acc.IsActive || !s.checkPassword(acc, auth.Password) {
+		writeError(w, http.StatusUnauthorized, ErrorCodeUnauthenticated, "invalid username or password")
+		return
+	}
+
+	token := randomHex(16)
+	s.tokens[token] = acc.ID
+
+	writeJSON(w, http.StatusOK, client.NewLoginResponse(token))
+}
+
+// checkPassword check a login password against the account, or against the LDAP directory for LDAP users.
+// The caller must hold the lock.
+func (s *Server) checkPassword(acc *account, password string) bool {
+	if acc.IsImported {
+		ldapPassword, ok := s.ldap.users[acc.Name]
+		return ok && ldapPassword != "" && ldapPassword == password
+	}
+	return acc.password != "" && acc.password == password
+}
+
+// authenticate find the account for the request bearer token, writing an error response if there is none.
+// The caller must hold the lock.
+func (s *Server) authenticate(w http.ResponseWriter, r *http.Request) *account {
+	token := strings.TrimPrefix(r.Header.Get(client.HeaderKeyAuthorization), "Bearer ")
+
+	if id, ok := s.tokens[token]; ok {
+		if acc, ok := s.accounts[id]; ok && acc.IsActive {
+			return acc
+		}
+	}
+
+	writeError(w, http.StatusUnauthorized, ErrorCodeUnauthenticated, "missing or invalid auth token")
+	return nil
+}
+
+// pathIs do the path segments exactly match.
+func pathIs(segs []string, match ...string) bool {
+	if len(segs) != len(match) {
+		return false
+	}
+	for i := range segs {
+		if segs[i] != match[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func readJSON(w http.ResponseWriter, r *http.Request, target interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(target); err != nil {
+		writeError(w, http.StatusBadRequest, ErrorCodeInvalidJSON, err.Error())
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body) //nolint:errcheck
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, apiErrors{Errors: []apiError{{Code: code, Message: message}}})
+}
+
+func writeMethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	writeError(w, http.StatusMethodNotAllowed, ErrorCodeMethodNotFound, r.Method+" is not supported for "+r.URL.Path)
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	rand.Read(b) //nolint:errcheck
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,645 @@
+package mketest_test
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/Mirantis/terraform-provider-mke/internal/mketest"
+)
+
+func TestFakeLogin(t *testing.T) {
+	ctx := context.Background()
+
+	s := mketest.NewServer()
+	defer s.Close()
+
+	c, _ := s.Client()
+	if err := c.ApiLogin(ctx); err != nil {
+		t.Fatalf("admin login failed: %s", err)
+	}
+
+	bad, _ := s.ClientFor(mketest.DefaultAdminUsername, "notthepassword")
+	if err := bad.ApiLogin(ctx); !errors.Is(err, client.ErrUnauthorizedReq) {
+		t.Errorf("expected an unauthorized error for a bad password, got: %v", err)
+	}
+}
+
+func TestFakeAccountLifecycle(t *testing.T) {
+	ctx := context.Background()
+
+	s := mketest.NewServer()
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	created, err := c.ApiCreateAccount(ctx, client.CreateAccount{
+		Name:     "testuser",
+		Password: "testpassword",
+		FullName: "Test User",
+		IsActive: true,
+	})
+	if err != nil {
+		t.Fatalf("create account failed: %s", err)
+	}
+	if created.ID == "" || created.Name != "testuser" || !created.IsActive {
+		t.Errorf("unexpected created account: %+v", created)
+	}
+
+	if _, err := c.ApiCreateAccount(ctx, client.CreateAccount{Name: "testuser", Password: "testpassword"}); !errors.Is(err, client.ErrResponseError) {
+		t.Errorf("expected a duplicate account to be rejected, got: %v", err)
+	}
+
+	read, err := c.ApiReadAccount(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("read account by ID failed: %s", err)
+	}
+	if read != created {
+		t.Errorf("read account does not match created: %+v != %+v", read, created)
+	}
+
+	updated, err := c.ApiUpdateAccount(ctx, created.Name, client.UpdateAccount{FullName: client.Ptr("New Name"), IsAdmin: client.Ptr(true)})
+	if err != nil {
+		t.Fatalf("update account failed: %s", err)
+	}
+	if updated.FullName != "New Name" || !updated.IsAdmin {
+		t.Errorf("account was not updated: %+v", updated)
+	}
+
+	updated, err = c.ApiUpdateAccount(ctx, created.ID, client.UpdateAccount{IsActive: client.Ptr(false), IsAdmin: client.Ptr(false)})
+	if err != nil {
+		t.Fatalf("update account failed: %s", err)
+	}
+	if updated.FullName != "New Name" || updated.IsAdmin || updated.IsActive {
+		t.Errorf("account flags were not set back to false: %+v", updated)
+	}
+
+	accs, err := c.ApiReadAccounts(ctx, client.AccountFilterUsers)
+	if err != nil {
+		t.Fatalf("list accounts failed: %s", err)
+	}
+	if len(accs) != 2 {
+		t.Errorf("expected the admin and test accounts, got: %+v", accs)
+	}
+
+	if err := c.ApiDeleteAccount(ctx, created.ID); err != nil {
+		t.Fatalf("delete account failed: %s", err)
+	}
+	if _, err := c.ApiReadAccount(ctx, created.ID); !errors.Is(err, client.ErrUnknownTarget) {
+		t.Errorf("expected a not found error reading a deleted account, got: %v", err)
+	}
+	if _, ok := s.Account(created.ID); ok {
+		t.Error("deleted account still exists in the server state")
+	}
+}
+
+func TestFakeTeamLifecycle(t *testing.T) {
+	ctx := context.Background()
+
+	s := mketest.NewServer()
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	if _, err := c.ApiCreateOrg(ctx, client.CreateOrg{Name: "testorg"}); err != nil {
+		t.Fatalf("create org failed: %s", err)
+	}
+
+	for _, name := range []string{"team-b", "team-a"} {
+		if _, err := c.ApiTeamCreate(ctx, "testorg", client.CreateTeam{Name: name}); err != nil {
+			t.Fatalf("create team %s failed: %s", name, err)
+		}
+	}
+	if _, err := c.ApiTeamCreate(ctx, "testorg", client.CreateTeam{Name: "team-a"}); !errors.Is(err, client.ErrResponseError) {
+		t.Errorf("expected a duplicate team to be rejected, got: %v", err)
+	}
+	if _, err := c.ApiTeamCreate(ctx, mketest.DefaultAdminUsername, client.CreateTeam{Name: "team-a"}); !client.IsNotFound(err) {
+		t.Errorf("expected teams to be rejected for a user account, got: %v", err)
+	}
+
+	updated, err := c.ApiTeamUpdate(ctx, "testorg", "team-a", client.UpdateTeam{Description: "first team"})
+	if err != nil {
+		t.Fatalf("update team failed: %s", err)
+	}
+	if updated.Description != "first team" {
+		t.Errorf("team was not updated: %+v", updated)
+	}
+
+	teams, err := c.ApiTeamList(ctx, "testorg")
+	if err != nil {
+		t.Fatalf("list teams failed: %s", err)
+	}
+	if len(teams) != 2 || teams[0].Name != "team-a" {
+		t.Errorf("expected both teams sorted by name, got: %+v", teams)
+	}
+	if org, _ := s.Account("testorg"); org.TeamsCount != 2 {
+		t.Errorf("org teams count was not updated: %+v", org)
+	}
+
+	if err := c.ApiTeamDelete(ctx, "testorg", updated.ID); err != nil {
+		t.Fatalf("delete team by ID failed: %s", err)
+	}
+	if _, err := c.ApiTeamRead(ctx, "testorg", "team-a"); !client.IsNotFound(err) {
+		t.Errorf("expected a not found error reading a deleted team, got: %v", err)
+	}
+}
+
+func TestFakeTeamMembers(t *testing.T) {
+	ctx := context.Background()
+
+	s := mketest.NewServer()
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	s.CreateAccount(client.CreateAccount{Name: "testorg", IsOrg: true})
+	s.CreateAccount(client.CreateAccount{Name: "user1", Password: "password", IsActive: true})
+	s.CreateTeam("testorg", client.CreateTeam{Name: "testteam"})
+
+	if _, err := c.ApiTeamMemberSet(ctx, "testorg", "testteam", "user1", true); err != nil {
+		t.Fatalf("set team member failed: %s", err)
+	}
+	if _, err := c.ApiTeamMemberSet(ctx, "testorg", "testteam", "nobody", false); !client.IsNotFound(err) {
+		t.Errorf("expected an unknown user to be rejected, got: %v", err)
+	}
+
+	m, err := c.ApiTeamMemberRead(ctx, "testorg", "testteam", "user1")
+	if err != nil {
+		t.Fatalf("read team member failed: %s", err)
+	}
+	if m.Member.Name != "user1" || !m.IsAdmin {
+		t.Errorf("unexpected team member: %+v", m)
+	}
+	if team, _ := s.Team("testorg", "testteam"); team.MembersCount != 1 {
+		t.Errorf("team members count was not updated: %+v", team)
+	}
+
+	s.RemoveAccount("user1")
+
+	members, err := c.ApiTeamMemberList(ctx, "testorg", "testteam")
+	if err != nil {
+		t.Fatalf("list team members failed: %s", err)
+	}
+	if len(members) != 0 {
+		t.Errorf("deleted account is still a team member: %+v", members)
+	}
+}
+
+func TestFakeOrgMembers(t *testing.T) {
+	ctx := context.Background()
+
+	s := mketest.NewServer()
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	s.CreateAccount(client.CreateAccount{Name: "testorg", IsOrg: true})
+	s.CreateAccount(client.CreateAccount{Name: "user1", Password: "password", IsActive: true})
+	s.CreateTeam("testorg", client.CreateTeam{Name: "testteam"})
+
+	// adding a team member implicitly makes it an org member
+	if _, err := c.ApiTeamMemberSet(ctx, "testorg", "testteam", "user1", true); err != nil {
+		t.Fatalf("set team member failed: %s", err)
+	}
+	m, err := c.ApiOrgMemberRead(ctx, "testorg", "user1")
+	if err != nil {
+		t.Fatalf("team member was not made an org member: %s", err)
+	}
+	if m.IsAdmin {
+		t.Errorf("implicit org member should not be an org admin: %+v", m)
+	}
+
+	teams, err := c.ApiOrgMemberTeams(ctx, "testorg", "user1")
+	if err != nil {
+		t.Fatalf("list member teams failed: %s", err)
+	}
+	if len(teams) != 1 || teams[0].Name != "testteam" {
+		t.Errorf("unexpected member teams: %+v", teams)
+	}
+
+	if _, err := c.ApiOrgMemberSet(ctx, "testorg", "user1", true); err != nil {
+		t.Fatalf("set org member failed: %s", err)
+	}
+	if members, _ := s.OrgMembers("testorg"); !members["user1"] {
+		t.Errorf("org member was not made an admin: %+v", members)
+	}
+
+	orgs, err := c.ApiAccountOrgs(ctx, "user1")
+	if err != nil {
+		t.Fatalf("list account orgs failed: %s", err)
+	}
+	if len(orgs) != 1 || orgs[0].Org.Name != "testorg" || !orgs[0].IsAdmin {
+		t.Errorf("unexpected account orgs: %+v", orgs)
+	}
+
+	// only admins can list the orgs of other accounts
+	uc, _ := s.ClientFor("user1", "password")
+	if _, err := uc.ApiAccountOrgs(ctx, mketest.DefaultAdminUsername); !client.IsForbidden(err) {
+		t.Errorf("expected listing the orgs of another account to be forbidden, got: %v", err)
+	}
+
+	// removing an org member removes it from the org teams
+	if err := c.ApiOrgMemberRemove(ctx, "testorg", "user1"); err != nil {
+		t.Fatalf("remove org member failed: %s", err)
+	}
+	if members, _ := s.TeamMembers("testorg", "testteam"); len(members) != 0 {
+		t.Errorf("removed org member is still a team member: %+v", members)
+	}
+}
+
+func TestFakeNonAdminCannotCreate(t *testing.T) {
+	ctx := context.Background()
+
+	s := mketest.NewServer()
+	defer s.Close()
+
+	s.CreateAccount(client.CreateAccount{Name: "plainuser", Password: "plainpassword", IsActive: true})
+
+	c, _ := s.ClientFor("plainuser", "plainpassword")
+	if _, err := c.ApiCreateAccount(ctx, client.CreateAccount{Name: "another", Password: "anotherpass"}); !errors.Is(err, client.ErrResponseError) {
+		t.Errorf("expected a non-admin create to be rejected, got: %v", err)
+	}
+}
+
+func TestFakeClientBundle(t *testing.T) {
+	ctx := context.Background()
+
+	s := mketest.NewServer()
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	cb, err := c.ApiClientBundleCreate(ctx, "my bundle")
+	if err != nil {
+		t.Fatalf("client bundle create failed: %s", err)
+	}
+
+	if cb.ID == "" || cb.Meta.Name != cb.ID {
+		t.Errorf("client bundle has no ID from its meta: %+v", cb)
+	}
+	if cb.Meta.Description != "my bundle" {
+		t.Errorf("client bundle meta has the wrong description: %s", cb.Meta.Description)
+	}
+	if cb.Kube == nil || !strings.HasSuffix(cb.Kube.Host, ":"+mketest.FakeKubePort) {
+		t.Errorf("client bundle kube config was not parsed: %+v", cb.Kube)
+	}
+
+	block, _ := pem.Decode([]byte(cb.Cert))
+	if block == nil {
+		t.Fatalf("client bundle cert is not PEM: %s", cb.Cert)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatalf("client bundle cert could not be parsed: %s", err)
+	}
+	if cert.Subject.CommonName != mketest.DefaultAdminUsername {
+		t.Errorf("client bundle cert is for the wrong user: %s", cert.Subject.CommonName)
+	}
+	if cb.Kube.ClientCertificate != cb.Cert || cb.Kube.ClientKey != cb.PrivateKey || cb.Kube.CACertificate != cb.CACert {
+		t.Error("kube config credentials do not match the bundle files")
+	}
+
+	key, err := c.ApiClientBundleGetPublicKey(ctx, cb)
+	if err != nil {
+		t.Fatalf("client bundle public key was not found: %s", err)
+	}
+	if key.Label != "my bundle" {
+		t.Errorf("client bundle key has the wrong label: %s", key.Label)
+	}
+
+	if err := c.ApiClientBundleDelete(ctx, cb); err != nil {
+		t.Fatalf("client bundle delete failed: %s", err)
+	}
+	if keys := s.PublicKeys(mketest.DefaultAdminUsername); len(keys) != 0 {
+		t.Errorf("client bundle key was not deleted: %+v", keys)
+	}
+}
+
+func TestFakeLDAPSettings(t *testing.T) {
+	ctx := context.Background()
+
+	s := mketest.NewServer()
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	s.AddLDAPUser("ldapuser", "ldappassword")
+
+	settings := client.LDAPSettings{
+		ServerURL:         "ldaps://ldap.example.com",
+		ReaderDN:          "cn=reader,dc=example,dc=com",
+		ReaderPassword:    "readerpassword",
+		UserSearchConfigs: []client.LDAPUserSearchConfig{{BaseDN: "dc=example,dc=com", UsernameAttr: "uid"}},
+	}
+
+	if _, err := c.ApiLDAPTryLogin(ctx, client.LDAPTryLogin{Username: "ldapuser", Password: "ldappassword", LDAPSettings: settings}); err != nil {
+		t.Errorf("expected the test login to succeed: %s", err)
+	}
+	if _, err := c.ApiLDAPTryLogin(ctx, client.LDAPTryLogin{Username: "ldapuser", Password: "wrong", LDAPSettings: settings}); !errors.Is(err, client.ErrLDAPLoginFailed) {
+		t.Errorf("expected the test login to fail, got: %v", err)
+	}
+
+	updated, err := c.ApiLDAPSettingsUpdate(ctx, settings)
+	if err != nil {
+		t.Fatalf("update LDAP settings failed: %s", err)
+	}
+	if updated.ReaderPassword != "" {
+		t.Error("the reader password was returned by the API")
+	}
+
+	// the stored reader password is kept when it is not sent
+	settings.ReaderPassword = ""
+	if _, err := c.ApiLDAPSettingsUpdate(ctx, settings); err != nil {
+		t.Fatalf("update LDAP settings failed: %s", err)
+	}
+	if _, password := s.LDAPSettings(); password != "readerpassword" {
+		t.Errorf("the reader password was not kept: %q", password)
+	}
+}
+
+func TestFakeSAMLSettings(t *testing.T) {
+	ctx := context.Background()
+
+	s := mketest.NewServer()
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	if _, err := c.ApiSAMLMetadata(ctx); !client.IsNotFound(err) {
+		t.Errorf("expected no SAML metadata before the service provider host is set, got: %v", err)
+	}
+
+	if _, err := c.ApiSAMLSettingsUpdate(ctx, client.SAMLSettings{Enabled: true}); err == nil {
+		t.Error("expected enabling SAML without an IdP to fail")
+	}
+
+	settings := client.SAMLSettings{
+		Enabled:        true,
+		IdPMetadataURL: "https://idp.example.com/metadata",
+		SPHost:         "https://mke.example.com",
+	}
+	updated, err := c.ApiSAMLSettingsUpdate(ctx, settings)
+	if err != nil {
+		t.Fatalf("update SAML settings failed: %s", err)
+	}
+	if updated.TeamMappings == nil {
+		t.Error("expected an empty list of team mappings")
+	}
+
+	metadata, err := c.ApiSAMLMetadata(ctx)
+	if err != nil {
+		t.Fatalf("read SAML metadata failed: %s", err)
+	}
+	if !strings.Contains(metadata, settings.SPHost) || metadata != s.SAMLMetadata() {
+		t.Errorf("unexpected SAML metadata: %s", metadata)
+	}
+}
+
+func TestFakeSCIMTokens(t *testing.T) {
+	ctx := context.Background()
+
+	s := mketest.NewServer()
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	token, err := c.ApiSCIMTokenCreate(ctx, client.CreateSCIMToken{Description: "okta"})
+	if err != nil {
+		t.Fatalf("create SCIM token failed: %s", err)
+	}
+	if token.Token == "" {
+		t.Error("expected the token value when the token is created")
+	}
+
+	read, err := c.ApiSCIMTokenRead(ctx, token.ID)
+	if err != nil {
+		t.Fatalf("read SCIM token failed: %s", err)
+	}
+	if read.Token != "" || read.Description != "okta" {
+		t.Errorf("unexpected SCIM token: %+v", read)
+	}
+
+	s.RevokeSCIMToken(token.ID)
+
+	if _, err := c.ApiSCIMTokenRead(ctx, token.ID); !client.IsNotFound(err) {
+		t.Errorf("expected a revoked token to be not found, got: %v", err)
+	}
+	if err := c.ApiSCIMTokenDelete(ctx, token.ID); !client.IsNotFound(err) {
+		t.Errorf("expected deleting a revoked token to be not found, got: %v", err)
+	}
+}
+
+func TestFakeLDAPSyncJobs(t *testing.T) {
+	ctx := context.Background()
+
+	s := mketest.NewServer()
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	// without LDAP settings the sync fails
+	job, err := c.ApiLDAPSyncStart(ctx)
+	if err != nil {
+		t.Fatalf("start LDAP sync failed: %s", err)
+	}
+	if job.Status != client.JobStatusWaiting {
+		t.Errorf("expected a new job to be waiting, got: %s", job.Status)
+	}
+	if _, err := c.ApiJobWait(ctx, job.ID, time.Millisecond); !errors.Is(err, client.ErrJobFailed) {
+		t.Errorf("expected the sync to fail without LDAP settings, got: %v", err)
+	}
+
+	if _, err := c.ApiLDAPSettingsUpdate(ctx, client.LDAPSettings{
+		ServerURL:         "ldaps://ldap.example.com",
+		UserSearchConfigs: []client.LDAPUserSearchConfig{{BaseDN: "dc=example,dc=com", UsernameAttr: "uid"}},
+	}); err != nil {
+		t.Fatalf("update LDAP settings failed: %s", err)
+	}
+
+	job, _ = c.ApiLDAPSyncStart(ctx)
+	if _, err := c.ApiJobWait(ctx, job.ID, time.Millisecond); err != nil {
+		t.Errorf("expected the sync to succeed, got: %v", err)
+	}
+
+	latest, ok, err := c.ApiLDAPSyncLatest(ctx)
+	if err != nil || !ok || latest.ID != job.ID {
+		t.Errorf("expected the latest sync to be %s, got %+v, %t, %v", job.ID, latest, ok, err)
+	}
+	if jobs := s.Jobs(client.JobActionLDAPSync); len(jobs) != 2 {
+		t.Errorf("expected 2 sync jobs, got %+v", jobs)
+	}
+
+	logs, err := c.ApiJobLogs(ctx, job.ID)
+	if err != nil || len(logs) == 0 {
+		t.Errorf("expected logs for the finished sync, got %+v, %v", logs, err)
+	}
+}
+
+func TestFakeLDAPUsers(t *testing.T) {
+	ctx := context.Background()
+
+	s := mketest.NewServer()
+	defer s.Close()
+
+	c, _ := s.Client()
+
+	ldapUser := client.CreateAccount{Name: "ldapuser", IsActive: true, SearchLDAP: true}
+
+	if _, err := c.ApiCreateAccount(ctx, ldapUser); err == nil {
+		t.Error("expected LDAP user creation to fail without LDAP settings")
+	}
+
+	if _, err := c.ApiLDAPSettingsUpdate(ctx, client.LDAPSettings{ServerURL: "ldaps://ldap.example.com"}); err != nil {
+		t.Fatalf("update LDAP settings failed: %s", err)
+	}
+	if _, err := c.ApiCreateAccount(ctx, ldapUser); err == nil {
+		t.Error("expected LDAP user creation to fail for a user who is not in the directory")
+	}
+
+	s.AddLDAPUser("ldapuser", "ldappassword")
+
+	acc, err := c.ApiCreateAccount(ctx, ldapUser)
+	if err != nil {
+		t.Fatalf("create LDAP user failed: %s", err)
+	}
+	if !acc.IsImported {
+		t.Error("expected the LDAP user to be marked as imported")
+	}
+
+	ldapClient, _ := s.ClientFor("ldapuser", "ldappassword")
+	if err := ldapClient.ApiLogin(ctx); err != nil {
+		t.Errorf("expected the LDAP user to log in with the directory password: %s", err)
+	}
+	bad, _ := s.ClientFor("ldapuser", "notthepassword")
+	if err := bad.ApiLogin(ctx); !errors.Is(err, client.ErrUnauthorizedReq) {
+		t.Errorf("expected an unauthorized error for a bad LDAP password, got: %v", err)
+	}
+}
+
+func TestFakeChangePassword(t *testing.T) {
+	ctx := context.Background()
+
+	s := mketest.NewServer()
+	defer s.Close()
+
+	admin, _ := s.Client()
+
+	s.CreateAccount(client.CreateAccount{Name: "plainuser", Password: "plainpassword", IsActive: true})
+	s.CreateAccount(client.CreateAccount{Name: "otheruser", Password: "otherpassword", IsActive: true})
+
+	c, _ := s.ClientFor("plainuser", "plainpassword")
+	if _, err := c.ApiChangePassword(ctx, "plainuser", "wrongpassword", "newpassword"); err == nil {
+		t.Error("expected a change with the wrong old password to fail")
+	}
+	if _, err := c.ApiChangePassword(ctx, "plainuser", "plainpassword", "newpassword"); err != nil {
+		t.Errorf("change own password failed: %s", err)
+	}
+	if password, _ := s.AccountPassword("plainuser"); password != "newpassword" {
+		t.Errorf("expected the password to change, got %q", password)
+	}
+
+	if _, err := c.ApiResetPassword(ctx, "otheruser", "newpassword"); !client.IsForbidden(err) {
+		t.Errorf("expected a non-admin reset to be forbidden, got: %v", err)
+	}
+	if _, err := admin.ApiResetPassword(ctx, "otheruser", "resetpassword"); err != nil {
+		t.Errorf("admin reset password failed: %s", err)
+	}
+	if password, _ := s.AccountPassword("otheruser"); password != "resetpassword" {
+		t.Errorf("expected the password to be reset, got %q", password)
+	}
+	if changes := s.PasswordChanges("otheruser"); changes != 1 {
+		t.Errorf("expected 1 password change, got %d", changes)
+	}
+}
+
+func TestFakeResetOTP(t *testing.T) {
+	ctx := context.Background()
+
+	s := mketest.NewServer()
+	defer s.Close()
+
+	admin, _ := s.Client()
+
+	s.CreateAccount(client.CreateAccount{Name: "plainuser", Password: "plainpassword", IsActive: true})
+	s.CreateAccount(client.CreateAccount{Name: "otheruser", Password: "otherpassword", IsActive: true})
+	for _, name := range []string{"plainuser", "otheruser"} {
+		s.ModifyAccount(name, func(acc *client.ResponseAccount) { acc.OtpEnabled = true })
+	}
+
+	c, _ := s.ClientFor("plainuser", "plainpassword")
+	if err := c.ApiResetAccountOTP(ctx, "otheruser"); !client.IsForbidden(err) {
+		t.Errorf("expected a non-admin reset of another account to be forbidden, got: %v", err)
+	}
+	if err := c.ApiResetAccountOTP(ctx, "plainuser"); err != nil {
+		t.Errorf("users can reset their own OTP: %s", err)
+	}
+
+	if err := admin.ApiResetAccountOTP(ctx, "otheruser"); err != nil {
+		t.Fatalf("admin reset OTP failed: %s", err)
+	}
+	for _, name := range []string{"plainuser", "otheruser"} {
+		if acc, _ := s.Account(name); acc.OtpEnabled {
+			t.Errorf("OTP of %s was not reset", name)
+		}
+	}
+}
+
+func TestFakeUploadPublicKey(t *testing.T) {
+	ctx := context.Background()
+
+	s := mketest.NewServer()
+	defer s.Close()
+
+	c, _ := s.Client()
+	s.CreateAccount(client.CreateAccount{Name: "service", Password: "servicepassword", IsActive: true})
+
+	pubPEM, certPEM, err := s.IssueKeyPair("service")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, otherCertPEM, err := s.IssueKeyPair("other")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.ApiPublicKeyCreate(ctx, "service", client.CreatePublicKey{PublicKey: "not a key"}); err == nil {
+		t.Error("expected an invalid public key to be rejected")
+	}
+	if _, err := c.ApiPublicKeyCreate(ctx, "service", client.CreatePublicKey{
+		PublicKey:    pubPEM,
+		Certificates: []client.Certificate{{Label: "other", Cert: otherCertPEM}},
+	}); err == nil {
+		t.Error("expected a certificate for another key to be rejected")
+	}
+
+	key, err := c.ApiPublicKeyCreate(ctx, "service", client.CreatePublicKey{
+		PublicKey:    pubPEM,
+		Label:        "hsm",
+		Certificates: []client.Certificate{{Label: "service", Cert: certPEM}},
+	})
+	if err != nil {
+		t.Fatalf("upload public key failed: %s", err)
+	}
+	if key.ID == "" || key.Label != "hsm" || len(key.Certificates) != 1 {
+		t.Errorf("unexpected uploaded key: %+v", key)
+	}
+	if _, err := c.ApiPublicKeyCreate(ctx, "service", client.CreatePublicKey{PublicKey: pubPEM}); err == nil {
+		t.Error("expected a duplicate key to be rejected")
+	}
+
+	updated, err := c.ApiPublicKeyUpdate(ctx, "service", key.ID, client.UpdatePublicKey{Label: "renamed"})
+	if err != nil {
+		t.Fatalf("update public key failed: %s", err)
+	}
+	if updated.Label != "renamed" || updated.PublicKey != pubPEM {
+		t.Errorf("unexpected updated key: %+v", updated)
+	}
+	if keys := s.PublicKeys("service"); len(keys) != 1 || keys[0].Label != "renamed" {
+		t.Errorf("unexpected keys in server state: %+v", keys)
+	}
+}
@@ -0,0 +1,159 @@
+package mketest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+// TeamMembers the current members of a team, by account name to whether they are team admins.
+func (s *Server) TeamMembers(org, teamName string) (map[string]bool, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := s.findOrgTeam(org, teamName)
+	if t == nil {
+		return nil, false
+	}
+
+	members := map[string]bool{}
+	for id, isAdmin := range t.members {
+		if acc, ok := s.accounts[id]; ok {
+			members[acc.Name] = isAdmin
+		}
+	}
+	return members, true
+}
+
+// SetTeamMember add an account to a team directly in the server state, as if it was done outside of terraform.
+func (s *Server) SetTeamMember(org, teamName, member string, isAdmin bool) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o := s.findAccount(org)
+	t := s.findOrgTeam(org, teamName)
+	acc := s.findAccount(member)
+	if t == nil || acc == nil || acc.IsOrg {
+		return false
+	}
+	o.addImplicitOrgMember(acc.ID)
+	t.setMember(acc.ID, isAdmin)
+	return true
+}
+
+// RemoveTeamMember remove an account from a team directly in the server state, as if it was done outside of terraform.
+func (s *Server) RemoveTeamMember(org, teamName, member string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := s.findOrgTeam(org, teamName)
+	acc := s.findAccount(member)
+	if t == nil || acc == nil {
+		return false
+	}
+	if _, ok := t.members[acc.ID]; !ok {
+		return false
+	}
+	t.removeMember(acc.ID)
+	return true
+}
+
+// findOrgTeam find a team by org and team name or ID. The caller must hold the lock.
+func (s *Server) findOrgTeam(org, teamName string) *team {
+	acc := s.findAccount(org)
+	if acc == nil {
+		return nil
+	}
+	return acc.findTeam(teamName)
+}
+
+// setMember the caller must hold the lock.
+func (t *team) setMember(accountID string, isAdmin bool) {
+	t.members[accountID] = isAdmin
+	t.MembersCount = len(t.members)
+}
+
+// removeMember the caller must hold the lock.
+func (t *team) removeMember(accountID string) {
+	delete(t.members, accountID)
+	t.MembersCount = len(t.members)
+}
+
+// sortedMembers memberships sorted by account name. The caller must hold the lock.
+func (s *Server) sortedMembers(members map[string]bool) []client.ResponseMember {
+	ms := []client.ResponseMember{}
+	for id, isAdmin := range members {
+		if acc, ok := s.accounts[id]; ok {
+			ms = append(ms, client.ResponseMember{Member: acc.ResponseAccount, IsAdmin: isAdmin})
+		}
+	}
+	sort.Slice(ms, func(i, j int) bool { return ms[i].Member.Name < ms[j].Member.Name })
+	return ms
+}
+
+func (s *Server) handleTeamMembers(w http.ResponseWriter, r *http.Request, caller, org *account, t *team, segs []string) {
+	if len(segs) == 0 {
+		if r.Method != http.MethodGet {
+			writeMethodNotAllowed(w, r)
+			return
+		}
+
+		members := s.sortedMembers(t.members)
+		page, next := paginate(r, len(members), func(i int) string { return members[i].Member.Name })
+
+		writeJSON(w, http.StatusOK, client.ResponseMembers{
+			Members:       append([]client.ResponseMember{}, members[page[0]:page[1]]...),
+			NextPageStart: next,
+		})
+		return
+	}
+
+	if len(segs) > 1 {
+		writeError(w, http.StatusNotFound, ErrorCodeNotFound, "unknown API target "+r.URL.Path)
+		return
+	}
+
+	acc := s.findAccount(segs[0])
+	if acc == nil || acc.IsOrg {
+		writeError(w, http.StatusNotFound, ErrorCodeNotFound, fmt.Sprintf("no such user: %s", segs[0]))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		isAdmin, ok := t.members[acc.ID]
+		if !ok {
+			writeError(w, http.StatusNotFound, ErrorCodeNotFound, fmt.Sprintf("%s is not a member of team %s/%s", acc.Name, org.Name, t.Name))
+			return
+		}
+		writeJSON(w, http.StatusOK, client.ResponseMember{Member: acc.ResponseAccount, IsAdmin: isAdmin})
+	case http.MethodPut:
+		if !caller.IsAdmin {
+			writeError(w, http.StatusForbidden, ErrorCodeNotAuthorized, "only admins can change team members")
+			return
+		}
+
+		var f client.MemberForm
+		if !readJSON(w, r, &f) {
+			return
+		}
+		org.addImplicitOrgMember(acc.ID)
+		t.setMember(acc.ID, f.IsAdmin)
+		writeJSON(w, http.StatusOK, client.ResponseMember{Member: acc.ResponseAccount, IsAdmin: f.IsAdmin})
+	case http.MethodDelete:
+		if !caller.IsAdmin {
+			writeError(w, http.StatusForbidden, ErrorCodeNotAuthorized, "only admins can change team members")
+			return
+		}
+		if _, ok := t.members[acc.ID]; !ok {
+			writeError(w, http.StatusNotFound, ErrorCodeNotFound, fmt.Sprintf("%s is not a member of team %s/%s", acc.Name, org.Name, t.Name))
+			return
+		}
+		t.removeMember(acc.ID)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
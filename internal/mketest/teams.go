@@ -0,0 +1,258 @@
+package mketest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+// team server side team state.
+type team struct {
+	client.ResponseTeam
+
+	// members account IDs to whether they are team admins.
+	members map[string]bool
+	// syncConfig LDAP member sync settings.
+	syncConfig client.MemberSyncConfig
+}
+
+// responseTeams team listing response body.
+type responseTeams struct {
+	NextPageStart string                `json:"nextPageStart"`
+	Teams         []client.ResponseTeam `json:"teams"`
+}
+
+// updateTeamForm request body for team updates, nil fields are left unchanged.
+type updateTeamForm struct {
+	Description *string `json:"description"`
+}
+
+// CreateTeam add a team directly to the server state, as if it was made outside of terraform.
+func (s *Server) CreateTeam(org string, t client.CreateTeam) (client.ResponseTeam, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	acc := s.findAccount(org)
+	if acc == nil || !acc.IsOrg {
+		return client.ResponseTeam{}, false
+	}
+	return s.createTeam(acc, t).ResponseTeam, true
+}
+
+// TeamMemberSyncConfig the current LDAP sync config of a team.
+func (s *Server) TeamMemberSyncConfig(org, nameOrID string) (client.MemberSyncConfig, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := s.findOrgTeam(org, nameOrID)
+	if t == nil {
+		return client.MemberSyncConfig{}, false
+	}
+	return t.syncConfig, true
+}
+
+// Team retrieve the current server state of a team by org and team name or ID.
+func (s *Server) Team(org, nameOrID string) (client.ResponseTeam, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	acc := s.findAccount(org)
+	if acc == nil {
+		return client.ResponseTeam{}, false
+	}
+	t := acc.findTeam(nameOrID)
+	if t == nil {
+		return client.ResponseTeam{}, false
+	}
+	return t.ResponseTeam, true
+}
+
+// RemoveTeam delete a team from the server state, as if it was deleted outside of terraform.
+func (s *Server) RemoveTeam(org, nameOrID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	acc := s.findAccount(org)
+	if acc == nil {
+		return false
+	}
+	t := acc.findTeam(nameOrID)
+	if t == nil {
+		return false
+	}
+	acc.deleteTeam(t)
+	return true
+}
+
+// findTeam by name or ID. The caller must hold the lock.
+func (acc *account) findTeam(nameOrID string) *team {
+	if t, ok := acc.teams[nameOrID]; ok {
+		return t
+	}
+	for _, t := range acc.teams {
+		if t.Name == nameOrID {
+			return t
+		}
+	}
+	return nil
+}
+
+// sortedTeams all teams of the org sorted by name. The caller must hold the lock.
+func (acc *account) sortedTeams() []*team {
+	ts := make([]*team, 0, len(acc.teams))
+	for _, t := range acc.teams {
+		ts = append(ts, t)
+	}
+	sort.Slice(ts, func(i, j int) bool { return ts[i].Name < ts[j].Name })
+	return ts
+}
+
+// deleteTeam the caller must hold the lock.
+func (acc *account) deleteTeam(t *team) {
+	delete(acc.teams, t.ID)
+	acc.TeamsCount = len(acc.teams)
+}
+
+// createTeam the caller must hold the lock.
+func (s *Server) createTeam(org *account, f client.CreateTeam) *team {
+	t := &team{
+		ResponseTeam: client.ResponseTeam{
+			OrgID:       org.ID,
+			ID:          newID(),
+			Name:        f.Name,
+			Description: f.Description,
+		},
+		members: map[string]bool{},
+	}
+
+	if org.teams == nil {
+		org.teams = map[string]*team{}
+	}
+	org.teams[t.ID] = t
+	org.TeamsCount = len(org.teams)
+	return t
+}
+
+func (s *Server) handleTeams(w http.ResponseWriter, r *http.Request, caller, org *account, segs []string) {
+	if !org.IsOrg {
+		writeError(w, http.StatusNotFound, ErrorCodeNotFound, fmt.Sprintf("account %s is not an organization", org.Name))
+		return
+	}
+
+	if len(segs) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			teams := org.sortedTeams()
+			page, next := paginate(r, len(teams), func(i int) string { return teams[i].Name })
+
+			res := responseTeams{Teams: []client.ResponseTeam{}, NextPageStart: next}
+			for _, t := range teams[page[0]:page[1]] {
+				res.Teams = append(res.Teams, t.ResponseTeam)
+			}
+			writeJSON(w, http.StatusOK, res)
+		case http.MethodPost:
+			s.handleTeamCreate(w, r, caller, org)
+		default:
+			writeMethodNotAllowed(w, r)
+		}
+		return
+	}
+
+	t := org.findTeam(segs[0])
+	if t == nil {
+		writeError(w, http.StatusNotFound, ErrorCodeNotFound, fmt.Sprintf("no such team: %s/%s", org.Name, segs[0]))
+		return
+	}
+
+	if len(segs) > 1 {
+		if segs[1] == "members" {
+			s.handleTeamMembers(w, r, caller, org, t, segs[2:])
+			return
+		}
+		if pathIs(segs[1:], "memberSyncConfig") {
+			s.handleTeamMemberSyncConfig(w, r, caller, t)
+			return
+		}
+		writeError(w, http.StatusNotFound, ErrorCodeNotFound, "unknown API target "+r.URL.Path)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, t.ResponseTeam)
+	case http.MethodPatch:
+		if !caller.IsAdmin {
+			writeError(w, http.StatusForbidden, ErrorCodeNotAuthorized, "only admins can update teams")
+			return
+		}
+
+		var f updateTeamForm
+		if !readJSON(w, r, &f) {
+			return
+		}
+		if f.Description != nil {
+			t.Description = *f.Description
+		}
+		writeJSON(w, http.StatusOK, t.ResponseTeam)
+	case http.MethodDelete:
+		if !caller.IsAdmin {
+			writeError(w, http.StatusForbidden, ErrorCodeNotAuthorized, "only admins can delete teams")
+			return
+		}
+		org.deleteTeam(t)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) handleTeamCreate(w http.ResponseWriter, r *http.Request, caller, org *account) {
+	if !caller.IsAdmin {
+		writeError(w, http.StatusForbidden, ErrorCodeNotAuthorized, "only admins can create teams")
+		return
+	}
+
+	var f client.CreateTeam
+	if !readJSON(w, r, &f) {
+		return
+	}
+
+	if f.Name == "" {
+		writeError(w, http.StatusBadRequest, ErrorCodeInvalidForm, "a team name is required")
+		return
+	}
+	if org.findTeam(f.Name) != nil {
+		writeError(w, http.StatusConflict, ErrorCodeAlreadyExists, fmt.Sprintf("team %s/%s already exists", org.Name, f.Name))
+		return
+	}
+
+	t := s.createTeam(org, f)
+	writeJSON(w, http.StatusCreated, t.ResponseTeam)
+}
+
+func (s *Server) handleTeamMemberSyncConfig(w http.ResponseWriter, r *http.Request, caller *account, t *team) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, t.syncConfig)
+	case http.MethodPut:
+		if !caller.IsAdmin {
+			writeError(w, http.StatusForbidden, ErrorCodeNotAuthorized, "only admins can change team sync settings")
+			return
+		}
+
+		var msc client.MemberSyncConfig
+		if !readJSON(w, r, &msc) {
+			return
+		}
+		if err := msc.Validate(); err != nil {
+			writeError(w, http.StatusBadRequest, ErrorCodeInvalidForm, err.Error())
+			return
+		}
+		t.syncConfig = msc
+		writeJSON(w, http.StatusOK, t.syncConfig)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
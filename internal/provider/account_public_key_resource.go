@@ -0,0 +1,299 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &AccountPublicKeyResource{}
+var _ resource.ResourceWithImportState = &AccountPublicKeyResource{}
+
+type AccountPublicKeyResourceModel struct {
+	Id           types.String                       `tfsdk:"id"`
+	Account      types.String                       `tfsdk:"account"`
+	KeyID        types.String                       `tfsdk:"key_id"`
+	PublicKey    types.String                       `tfsdk:"public_key"`
+	Label        types.String                       `tfsdk:"label"`
+	Certificates []AccountPublicKeyCertificateModel `tfsdk:"certificate"`
+}
+
+type AccountPublicKeyCertificateModel struct {
+	Label types.String `tfsdk:"label"`
+	Cert  types.String `tfsdk:"cert"`
+}
+
+// clientCertificates convert the certificate blocks to client certificates.
+func (m AccountPublicKeyResourceModel) clientCertificates() []client.Certificate {
+	certs := []client.Certificate{}
+	for _, c := range m.Certificates {
+		certs = append(certs, client.Certificate{Label: c.Label.ValueString(), Cert: c.Cert.ValueString()})
+	}
+	return certs
+}
+
+// setKey refresh the model from the key in MKE. The public key PEM is kept as configured, unless it is not known, e.g. on import.
+func (m *AccountPublicKeyResourceModel) setKey(key client.AccountPublicKey) {
+	m.KeyID = types.StringValue(key.ID)
+	m.Id = types.StringValue(fmt.Sprintf("%s/%s", m.Account.ValueString(), key.ID))
+	m.Label = types.StringValue(key.Label)
+	if m.PublicKey.IsNull() || m.PublicKey.IsUnknown() {
+		m.PublicKey = types.StringValue(key.PublicKey)
+	}
+
+	m.Certificates = []AccountPublicKeyCertificateModel{}
+	for _, c := range key.Certificates {
+		m.Certificates = append(m.Certificates, AccountPublicKeyCertificateModel{
+			Label: types.StringValue(c.Label),
+			Cert:  types.StringValue(c.Cert),
+		})
+	}
+}
+
+type AccountPublicKeyResource struct {
+	providerModel MKEProviderModel
+}
+
+func NewAccountPublicKeyResource() resource.Resource {
+	return &AccountPublicKeyResource{}
+}
+
+func (r *AccountPublicKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_public_key"
+}
+
+func (r *AccountPublicKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A public key, made outside of MKE, which MKE trusts for an account. " +
+			"Use it for keys which are generated elsewhere, e.g. in an HSM, instead of an `mke_clientbundle`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier, as `account/key_id`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account": schema.StringAttribute{
+				MarkdownDescription: "The name or ID of the account that the key is for",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The MKE ID of the key, the hash of its DER bytes",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				MarkdownDescription: "The PEM encoded public key",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "The label or description of the key",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"certificate": schema.ListNestedBlock{
+				MarkdownDescription: "Certificates for the public key. Changing them uploads the key again",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"label": schema.StringAttribute{
+							MarkdownDescription: "The label of the certificate",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+						"cert": schema.StringAttribute{
+							MarkdownDescription: "The PEM encoded certificate",
+							Required:            true,
+							Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *AccountPublicKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	lpm, ok := req.ProviderData.(MKEProviderModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *MKEProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	tflog.Debug(ctx, "Successfully interpeted provider model", map[string]interface{}{})
+	r.providerModel = lpm
+}
+
+func (r *AccountPublicKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_account_public_key", "create")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data AccountPublicKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	key, err := cl.ApiPublicKeyCreate(ctx, data.Account.ValueString(), client.CreatePublicKey{
+		PublicKey:    data.PublicKey.ValueString(),
+		Label:        data.Label.ValueString(),
+		Certificates: data.clientCertificates(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Create public key error", err.Error())
+		return
+	}
+
+	data.setKey(key)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccountPublicKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_account_public_key", "read")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data AccountPublicKeyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	key, err := cl.ApiPublicKeyRetrieve(ctx, data.Account.ValueString(), data.KeyID.ValueString())
+	if client.IsNotFound(err) {
+		// the key was revoked outside of terraform, so it should be removed from state
+		resp.Diagnostics.AddWarning("Public key in state not found in MKE API", err.Error())
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Read public key error", err.Error())
+		return
+	}
+
+	data.setKey(key)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only the label can change in place, everything else uploads the key again.
+func (r *AccountPublicKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_account_public_key", "update")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data AccountPublicKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	key, err := cl.ApiPublicKeyUpdate(ctx, data.Account.ValueString(), data.KeyID.ValueString(), client.UpdatePublicKey{Label: data.Label.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Update public key error", err.Error())
+		return
+	}
+
+	data.setKey(key)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, "Updated 'account_public_key' resource", map[string]any{"success": true})
+}
+
+func (r *AccountPublicKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_account_public_key", "delete")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data AccountPublicKeyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	if err := cl.ApiPublicKeyDelete(ctx, data.Account.ValueString(), data.KeyID.ValueString()); client.IsNotFound(err) {
+		tflog.Debug(ctx, "Public key was already removed from MKE", map[string]any{"id": data.Id.ValueString()})
+	} else if err != nil {
+		resp.Diagnostics.AddError("Delete public key error", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted account_public_key resource", map[string]any{"success": true})
+}
+
+// ImportState public keys are imported with an `account/key_id` ID.
+func (r *AccountPublicKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, ok := splitImportID(req.ID, 2)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected import identifier", fmt.Sprintf("Expected an import identifier like `account/key_id`, got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key_id"), parts[1])...)
+}
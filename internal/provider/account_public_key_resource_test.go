@@ -0,0 +1,189 @@
+package provider_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/Mirantis/terraform-provider-mke/internal/mketest"
+)
+
+func TestAccountPublicKeyResourceDefault(t *testing.T) {
+	s := testAccFakeServer(t)
+	s.CreateAccount(client.CreateAccount{Name: "service", Password: "servicepassword", IsActive: true})
+
+	pubPEM, certPEM, err := s.IssueKeyPair("service")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckPublicKeys(s, "service", map[string]string{}),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(s) + testAccountPublicKeyResource(pubPEM, certPEM, "hsm"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("mke_account_public_key.test", "key_id"),
+					resource.TestMatchResourceAttr("mke_account_public_key.test", "id", regexp.MustCompile("^service/[0-9a-f]{64}$")),
+					resource.TestCheckResourceAttr("mke_account_public_key.test", "certificate.#", "1"),
+					testAccCheckPublicKeys(s, "service", map[string]string{"hsm": certPEM}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "mke_account_public_key.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(st *terraform.State) (string, error) {
+					return st.RootModule().Resources["mke_account_public_key.test"].Primary.Attributes["id"], nil
+				},
+				ImportStateVerify: true,
+			},
+			// The label is changed in place
+			{
+				Config: testAccProviderConfig(s) + testAccountPublicKeyResource(pubPEM, certPEM, "renamed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("mke_account_public_key.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: testAccCheckPublicKeys(s, "service", map[string]string{"renamed": certPEM}),
+			},
+			// Delete is called implicitly
+		},
+	})
+}
+
+func TestAccountPublicKeyResourceWrongCertificate(t *testing.T) {
+	s := testAccFakeServer(t)
+	s.CreateAccount(client.CreateAccount{Name: "service", Password: "servicepassword", IsActive: true})
+
+	pubPEM, _, err := s.IssueKeyPair("service")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, otherCertPEM, err := s.IssueKeyPair("other")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(s) + testAccountPublicKeyResource(pubPEM, otherCertPEM, "hsm"),
+				ExpectError: regexp.MustCompile(`is\s+not\s+for\s+the\s+public\s+key`),
+			},
+		},
+	})
+}
+
+func TestAccountPublicKeyResourceManyKeys(t *testing.T) {
+	s := testAccFakeServer(t)
+	s.CreateAccount(client.CreateAccount{Name: "service", Password: "servicepassword", IsActive: true})
+
+	// the account already has more keys than fit on a page of the key listing
+	for i := 0; i <= mketest.DefaultPageLimit; i++ {
+		s.AddPublicKey("service", fmt.Sprintf("bundle%d", i))
+	}
+	existing := mketest.DefaultPageLimit + 1
+
+	pubPEM, certPEM, err := s.IssueKeyPair("service")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckListedPublicKeys(s, "service", existing, ""),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(s) + testAccountPublicKeyResource(pubPEM, certPEM, "hsm"),
+				Check: func(st *terraform.State) error {
+					keyID := st.RootModule().Resources["mke_account_public_key.test"].Primary.Attributes["key_id"]
+					return testAccCheckListedPublicKeys(s, "service", existing+1, keyID)(st)
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName: "mke_account_public_key.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(st *terraform.State) (string, error) {
+					return st.RootModule().Resources["mke_account_public_key.test"].Primary.Attributes["id"], nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccountPublicKeyResource(pubPEM, certPEM, label string) string {
+	return fmt.Sprintf(`
+	resource "mke_account_public_key" "test" {
+		account = "service"
+		public_key = %q
+		label = %q
+
+		certificate {
+			label = "service"
+			cert = %q
+		}
+	}`, pubPEM, label, certPEM)
+}
+
+// testAccCheckPublicKeys confirm the labels and certificates of the public keys of an account in the fake MKE server.
+func testAccCheckPublicKeys(s *mketest.Server, account string, expected map[string]string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		keys := s.PublicKeys(account)
+		if len(keys) != len(expected) {
+			return fmt.Errorf("account %s has %d public keys, expected %d", account, len(keys), len(expected))
+		}
+		for _, k := range keys {
+			cert, ok := expected[k.Label]
+			if !ok {
+				return fmt.Errorf("account %s has an unexpected public key with label %q", account, k.Label)
+			}
+			if len(k.Certificates) != 1 || k.Certificates[0].Cert != cert {
+				return fmt.Errorf("public key %q of account %s does not have the expected certificate", k.Label, account)
+			}
+		}
+		return nil
+	}
+}
+
+// testAccCheckListedPublicKeys confirm that listing the public keys of an account, across all of the pages,
+// finds the expected number of keys, including the key with keyID if one is passed.
+func testAccCheckListedPublicKeys(s *mketest.Server, account string, count int, keyID string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		c, err := s.Client()
+		if err != nil {
+			return err
+		}
+		keys, err := c.ApiPublicKeyList(context.Background(), account)
+		if err != nil {
+			return err
+		}
+		if len(keys) != count {
+			return fmt.Errorf("listed %d public keys of account %s, expected %d", len(keys), account, count)
+		}
+		if keyID == "" {
+			return nil
+		}
+		for _, k := range keys {
+			if k.ID == keyID {
+				return nil
+			}
+		}
+		return fmt.Errorf("public key %s of account %s was not listed", keyID, account)
+	}
+}
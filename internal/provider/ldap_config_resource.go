@@ -0,0 +1,463 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// LDAPConfigID the ID of the singleton LDAP config.
+	LDAPConfigID = "ldap"
+)
+
+var _ resource.Resource = &LDAPConfigResource{}
+var _ resource.ResourceWithImportState = &LDAPConfigResource{}
+var _ resource.ResourceWithModifyPlan = &LDAPConfigResource{}
+
+type LDAPConfigResourceModel struct {
+	Id                    types.String          `tfsdk:"id"`
+	ServerURL             types.String          `tfsdk:"server_url"`
+	StartTLS              types.Bool            `tfsdk:"start_tls"`
+	TLSSkipVerify         types.Bool            `tfsdk:"tls_skip_verify"`
+	RootCerts             types.String          `tfsdk:"root_certs"`
+	NoSimplePagination    types.Bool            `tfsdk:"no_simple_pagination"`
+	BindDN                types.String          `tfsdk:"bind_dn"`
+	BindPasswordWO        types.String          `tfsdk:"bind_password_wo"`
+	BindPasswordWOVersion types.Int64           `tfsdk:"bind_password_wo_version"`
+	SyncSchedule          types.String          `tfsdk:"sync_schedule"`
+	JITUserProvisioning   types.Bool            `tfsdk:"jit_user_provisioning"`
+	UserSearch            []LDAPUserSearchModel `tfsdk:"user_search"`
+	TestLogin             *LDAPTestLoginModel   `tfsdk:"test_login"`
+}
+
+type LDAPUserSearchModel struct {
+	BaseDN               types.String `tfsdk:"base_dn"`
+	ScopeSubtree         types.Bool   `tfsdk:"scope_subtree"`
+	UsernameAttr         types.String `tfsdk:"username_attr"`
+	FullNameAttr         types.String `tfsdk:"full_name_attr"`
+	Filter               types.String `tfsdk:"filter"`
+	MatchGroup           types.Bool   `tfsdk:"match_group"`
+	MatchGroupDN         types.String `tfsdk:"match_group_dn"`
+	MatchGroupMemberAttr types.String `tfsdk:"match_group_member_attr"`
+	MatchGroupIterate    types.Bool   `tfsdk:"match_group_iterate"`
+}
+
+type LDAPTestLoginModel struct {
+	Username   types.String `tfsdk:"username"`
+	PasswordWO types.String `tfsdk:"password_wo"`
+}
+
+// LDAPSettings convert the model to client LDAP settings. The bind password is not included.
+func (m LDAPConfigResourceModel) LDAPSettings() client.LDAPSettings {
+	settings := client.LDAPSettings{
+		ServerURL:           m.ServerURL.ValueString(),
+		StartTLS:            m.StartTLS.ValueBool(),
+		TLSSkipVerify:       m.TLSSkipVerify.ValueBool(),
+		RootCerts:           m.RootCerts.ValueString(),
+		NoSimplePagination:  m.NoSimplePagination.ValueBool(),
+		ReaderDN:            m.BindDN.ValueString(),
+		SyncSchedule:        m.SyncSchedule.ValueString(),
+		JITUserProvisioning: m.JITUserProvisioning.ValueBool(),
+		UserSearchConfigs:   []client.LDAPUserSearchConfig{},
+	}
+
+	for _, us := range m.UserSearch {
+		settings.UserSearchConfigs = append(settings.UserSearchConfigs, client.LDAPUserSearchConfig{
+			BaseDN:               us.BaseDN.ValueString(),
+			ScopeSubtree:         us.ScopeSubtree.ValueBool(),
+			UsernameAttr:         us.UsernameAttr.ValueString(),
+			FullNameAttr:         us.FullNameAttr.ValueString(),
+			Filter:               us.Filter.ValueString(),
+			MatchGroup:           us.MatchGroup.ValueBool(),
+			MatchGroupDN:         us.MatchGroupDN.ValueString(),
+			MatchGroupMemberAttr: us.MatchGroupMemberAttr.ValueString(),
+			MatchGroupIterate:    us.MatchGroupIterate.ValueBool(),
+		})
+	}
+
+	return settings
+}
+
+// FromLDAPSettings populate the model from client LDAP settings.
+// The write-only bind password and the test login are left unchanged.
+func (m *LDAPConfigResourceModel) FromLDAPSettings(settings client.LDAPSettings) {
+	m.Id = types.StringValue(LDAPConfigID)
+	m.ServerURL = types.StringValue(settings.ServerURL)
+	m.StartTLS = types.BoolValue(settings.StartTLS)
+	m.TLSSkipVerify = types.BoolValue(settings.TLSSkipVerify)
+	m.RootCerts = types.StringValue(settings.RootCerts)
+	m.NoSimplePagination = types.BoolValue(settings.NoSimplePagination)
+	m.BindDN = types.StringValue(settings.ReaderDN)
+	m.SyncSchedule = types.StringValue(settings.SyncSchedule)
+	m.JITUserProvisioning = types.BoolValue(settings.JITUserProvisioning)
+
+	m.UserSearch = []LDAPUserSearchModel{}
+	for _, usc := range settings.UserSearchConfigs {
+		m.UserSearch = append(m.UserSearch, LDAPUserSearchModel{
+			BaseDN:               types.StringValue(usc.BaseDN),
+			ScopeSubtree:         types.BoolValue(usc.ScopeSubtree),
+			UsernameAttr:         types.StringValue(usc.UsernameAttr),
+			FullNameAttr:         types.StringValue(usc.FullNameAttr),
+			Filter:               types.StringValue(usc.Filter),
+			MatchGroup:           types.BoolValue(usc.MatchGroup),
+			MatchGroupDN:         types.StringValue(usc.MatchGroupDN),
+			MatchGroupMemberAttr: types.StringValue(usc.MatchGroupMemberAttr),
+			MatchGroupIterate:    types.BoolValue(usc.MatchGroupIterate),
+		})
+	}
+}
+
+type LDAPConfigResource struct {
+	providerModel MKEProviderModel
+}
+
+func NewLDAPConfigResource() resource.Resource {
+	return &LDAPConfigResource{}
+}
+
+func (r *LDAPConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ldap_config"
+}
+
+func (r *LDAPConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "LDAP authentication backend settings of the cluster. " +
+			"There is only one LDAP config per cluster, so only declare this resource once. " +
+			"Destroying the resource leaves the settings in place on the cluster.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier, always `ldap`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the LDAP server, e.g. `ldaps://ldap.example.com`",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"start_tls": schema.BoolAttribute{
+				MarkdownDescription: "Upgrade an `ldap://` connection with StartTLS",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"tls_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip verification of the LDAP server certificate. Use only for development systems",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"root_certs": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded root CA certificates used to verify the LDAP server",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"no_simple_pagination": schema.BoolAttribute{
+				MarkdownDescription: "The LDAP server does not support the simple paged results control",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"bind_dn": schema.StringAttribute{
+				MarkdownDescription: "The distinguished name used to bind to LDAP for searches",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"bind_password_wo": schema.StringAttribute{
+				MarkdownDescription: "The password for the bind DN. It is write-only, so it is never stored in state, " +
+					"and it is only sent when the resource is created or `bind_password_wo_version` changes. Requires Terraform 1.11 or later.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"bind_password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Change this value to send a new `bind_password_wo` to MKE",
+				Optional:            true,
+			},
+			"sync_schedule": schema.StringAttribute{
+				MarkdownDescription: "Cron style schedule for syncing users from LDAP, e.g. `@hourly`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("@hourly"),
+			},
+			"jit_user_provisioning": schema.BoolAttribute{
+				MarkdownDescription: "Create MKE users for LDAP users when they first log in",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"user_search": schema.ListNestedBlock{
+				MarkdownDescription: "A search for LDAP users which can log in to MKE",
+				Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"base_dn": schema.StringAttribute{
+							MarkdownDescription: "The distinguished name of the node to search from",
+							Required:            true,
+						},
+						"scope_subtree": schema.BoolAttribute{
+							MarkdownDescription: "Search the whole subtree of the base DN, instead of only one level",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"username_attr": schema.StringAttribute{
+							MarkdownDescription: "The LDAP attribute used as the MKE username, e.g. `uid`",
+							Required:            true,
+						},
+						"full_name_attr": schema.StringAttribute{
+							MarkdownDescription: "The LDAP attribute used as the MKE full name, e.g. `cn`",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+						"filter": schema.StringAttribute{
+							MarkdownDescription: "An extra LDAP filter for the users",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+						"match_group": schema.BoolAttribute{
+							MarkdownDescription: "Only include users which are members of a group",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"match_group_dn": schema.StringAttribute{
+							MarkdownDescription: "The distinguished name of the group that users must be members of",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+						"match_group_member_attr": schema.StringAttribute{
+							MarkdownDescription: "The group attribute which lists its members",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+						"match_group_iterate": schema.BoolAttribute{
+							MarkdownDescription: "Check group membership by looking up each group member, instead of with a search filter",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+			"test_login": schema.SingleNestedBlock{
+				MarkdownDescription: "An LDAP login which is tried with the planned settings before they are applied. " +
+					"The plan fails if the login does not work.",
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						MarkdownDescription: "The LDAP username to log in with",
+						Optional:            true,
+					},
+					"password_wo": schema.StringAttribute{
+						MarkdownDescription: "The password of the LDAP user. It is write-only, so it is never stored in state.",
+						Optional:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *LDAPConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	lpm, ok := req.ProviderData.(MKEProviderModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *MKEProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	tflog.Debug(ctx, "Successfully interpeted provider model", map[string]interface{}{})
+	r.providerModel = lpm
+}
+
+// ModifyPlan try the test login with the planned settings, so that broken settings fail the plan.
+func (r *LDAPConfigResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to test when destroying, or when the provider is not yet configured
+	if req.Plan.Raw.IsNull() || r.providerModel.Endpoint.IsNull() {
+		return
+	}
+
+	var plan, config LDAPConfigResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.TestLogin == nil || config.TestLogin.Username.ValueString() == "" {
+		return
+	}
+	if !req.Plan.Raw.IsFullyKnown() || config.TestLogin.Username.IsUnknown() || config.TestLogin.PasswordWO.IsUnknown() || config.BindPasswordWO.IsUnknown() {
+		tflog.Debug(ctx, "Skipping the LDAP test login until the planned settings are known")
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	settings := plan.LDAPSettings()
+	settings.ReaderPassword = config.BindPasswordWO.ValueString()
+
+	try := client.LDAPTryLogin{
+		Username:     config.TestLogin.Username.ValueString(),
+		Password:     config.TestLogin.PasswordWO.ValueString(),
+		LDAPSettings: settings,
+	}
+
+	if res, err := cl.ApiLDAPTryLogin(ctx, try); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("test_login"), "LDAP test login failed", fmt.Sprintf("%s\n\n%v", err.Error(), res.Logs))
+	}
+}
+
+func (r *LDAPConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_ldap_config", "create")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data, config LDAPConfigResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	settings := data.LDAPSettings()
+	settings.ReaderPassword = config.BindPasswordWO.ValueString()
+
+	rSettings, err := cl.ApiLDAPSettingsUpdate(ctx, settings)
+	if err != nil {
+		resp.Diagnostics.AddError("Create LDAP config error", err.Error())
+		return
+	}
+
+	data.FromLDAPSettings(rSettings)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LDAPConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_ldap_config", "read")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data LDAPConfigResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	rSettings, err := cl.ApiLDAPSettingsRead(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Read LDAP config error", err.Error())
+		return
+	}
+
+	data.FromLDAPSettings(rSettings)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LDAPConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_ldap_config", "update")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data, config, state LDAPConfigResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	settings := data.LDAPSettings()
+	// the write-only password is only sent when its version changes, otherwise MKE keeps the current one
+	if !data.BindPasswordWOVersion.Equal(state.BindPasswordWOVersion) {
+		settings.ReaderPassword = config.BindPasswordWO.ValueString()
+	}
+
+	rSettings, err := cl.ApiLDAPSettingsUpdate(ctx, settings)
+	if err != nil {
+		resp.Diagnostics.AddError("Update LDAP config error", err.Error())
+		return
+	}
+
+	data.FromLDAPSettings(rSettings)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, "Updated 'ldap_config' resource", map[string]any{"success": true})
+}
+
+func (r *LDAPConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	_, span := startOperationSpan(ctx, "mke_ldap_config", "delete")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	// removing the LDAP settings could lock LDAP users out of the cluster, so they are left in place
+	resp.Diagnostics.AddWarning(
+		"LDAP config left in MKE",
+		"The LDAP settings have been removed from the terraform state, but are still configured in MKE.",
+	)
+}
+
+func (r *LDAPConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,108 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/mketest"
+)
+
+func TestLDAPConfigResourceDefault(t *testing.T) {
+	s := testAccFakeServer(t)
+	s.AddLDAPUser("ldapuser", "ldappassword")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// write-only attributes need terraform 1.11
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// A failing test login fails the plan
+			{
+				Config:      testAccProviderConfig(s) + testLDAPConfigResource("secret", 1, "ldapuser", "wrongpassword"),
+				ExpectError: regexp.MustCompile("LDAP test login failed"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(s) + testLDAPConfigResource("secret", 1, "ldapuser", "ldappassword"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_ldap_config.test", "id", "ldap"),
+					resource.TestCheckResourceAttr("mke_ldap_config.test", "server_url", "ldaps://ldap.example.com"),
+					resource.TestCheckResourceAttr("mke_ldap_config.test", "sync_schedule", mketest.DefaultLDAPSyncSchedule),
+					resource.TestCheckResourceAttr("mke_ldap_config.test", "user_search.0.username_attr", "uid"),
+					resource.TestCheckNoResourceAttr("mke_ldap_config.test", "bind_password_wo"),
+					testAccCheckLDAPReaderPassword(s, "secret"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "mke_ldap_config.test",
+				ImportState:             true,
+				ImportStateId:           "ldap",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bind_password_wo_version", "test_login"},
+			},
+			// Changing the password without changing its version does not send it
+			{
+				Config: testAccProviderConfig(s) + testLDAPConfigResource("changed", 1, "ldapuser", "ldappassword"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLDAPReaderPassword(s, "secret"),
+				),
+			},
+			// Changing the version sends the new password
+			{
+				Config: testAccProviderConfig(s) + testLDAPConfigResource("changed", 2, "ldapuser", "ldappassword"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_ldap_config.test", "bind_password_wo_version", "2"),
+					testAccCheckLDAPReaderPassword(s, "changed"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase, and leaves the settings in place
+		},
+		CheckDestroy: func(*terraform.State) error {
+			if settings, _ := s.LDAPSettings(); settings.ServerURL == "" {
+				return fmt.Errorf("LDAP settings were removed from MKE")
+			}
+			return nil
+		},
+	})
+}
+
+func testLDAPConfigResource(bindPassword string, bindPasswordVersion int, username, password string) string {
+	return fmt.Sprintf(`
+	resource "mke_ldap_config" "test" {
+		server_url = "ldaps://ldap.example.com"
+		bind_dn = "cn=reader,dc=example,dc=com"
+		bind_password_wo = "%s"
+		bind_password_wo_version = %d
+
+		user_search {
+			base_dn = "ou=people,dc=example,dc=com"
+			username_attr = "uid"
+			full_name_attr = "cn"
+			scope_subtree = true
+		}
+
+		test_login {
+			username = "%s"
+			password_wo = "%s"
+		}
+	}`, bindPassword, bindPasswordVersion, username, password)
+}
+
+// testAccCheckLDAPReaderPassword confirm the LDAP reader password stored in the fake MKE server.
+func testAccCheckLDAPReaderPassword(s *mketest.Server, expected string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if _, password := s.LDAPSettings(); password != expected {
+			return fmt.Errorf("LDAP reader password is %q, expected %q", password, expected)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &LDAPSyncDataSource{}
+
+type LDAPSyncDataSourceModel struct {
+	Id           types.String   `tfsdk:"id"`
+	Status       types.String   `tfsdk:"status"`
+	ScheduledAt  types.String   `tfsdk:"scheduled_at"`
+	LastUpdated  types.String   `tfsdk:"last_updated"`
+	InfoCount    types.Int64    `tfsdk:"info_count"`
+	WarningCount types.Int64    `tfsdk:"warning_count"`
+	ErrorCount   types.Int64    `tfsdk:"error_count"`
+	Errors       []types.String `tfsdk:"errors"`
+}
+
+// FromResponseJob populate the model from an eNZi sync job and its logs.
+func (m *LDAPSyncDataSourceModel) FromResponseJob(job client.ResponseJob, logs []client.JobLogLine) {
+	m.Id = types.StringValue(job.ID)
+	m.Status = types.StringValue(job.Status)
+	m.ScheduledAt = types.StringValue(job.ScheduledAt)
+	m.LastUpdated = types.StringValue(job.LastUpdated)
+
+	counts := map[string]int64{}
+	m.Errors = []types.String{}
+	for _, l := range logs {
+		counts[l.Level]++
+		if l.Level == client.JobLogLevelError {
+			m.Errors = append(m.Errors, types.StringValue(l.Msg))
+		}
+	}
+
+	m.InfoCount = types.Int64Value(counts[client.JobLogLevelInfo])
+	m.WarningCount = types.Int64Value(counts[client.JobLogLevelWarning])
+	m.ErrorCount = types.Int64Value(counts[client.JobLogLevelError])
+}
+
+type LDAPSyncDataSource struct {
+	providerModel MKEProviderModel
+}
+
+func NewLDAPSyncDataSource() datasource.DataSource {
+	return &LDAPSyncDataSource{}
+}
+
+func (d *LDAPSyncDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ldap_sync"
+}
+
+func (d *LDAPSyncDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The most recent LDAP sync job of the cluster. " +
+			"The job attributes are empty, and the counts zero, if LDAP has never been synced.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the sync job",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the sync job, one of `waiting`, `running`, `done`, `canceled` or `errored`",
+				Computed:            true,
+			},
+			"scheduled_at": schema.StringAttribute{
+				MarkdownDescription: "When the sync job was scheduled",
+				Computed:            true,
+			},
+			"last_updated": schema.StringAttribute{
+				MarkdownDescription: "When the status of the sync job last changed",
+				Computed:            true,
+			},
+			"info_count": schema.Int64Attribute{
+				MarkdownDescription: "How many info lines the sync job logged",
+				Computed:            true,
+			},
+			"warning_count": schema.Int64Attribute{
+				MarkdownDescription: "How many warnings the sync job logged",
+				Computed:            true,
+			},
+			"error_count": schema.Int64Attribute{
+				MarkdownDescription: "How many errors the sync job logged",
+				Computed:            true,
+			},
+			"errors": schema.ListAttribute{
+				MarkdownDescription: "The errors which the sync job logged",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *LDAPSyncDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	lpm, ok := req.ProviderData.(MKEProviderModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *MKEProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	tflog.Debug(ctx, "Successfully interpeted provider model", map[string]interface{}{})
+	d.providerModel = lpm
+}
+
+func (d *LDAPSyncDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_ldap_sync", "read")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data LDAPSyncDataSourceModel
+
+	cl, err := d.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	job, ok, err := cl.ApiLDAPSyncLatest(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Read LDAP sync error", err.Error())
+		return
+	}
+
+	logs := []client.JobLogLine{}
+	if ok {
+		if logs, err = cl.ApiJobLogs(ctx, job.ID); err != nil {
+			resp.Diagnostics.AddError("Read LDAP sync error", err.Error())
+			return
+		}
+	}
+
+	data.FromResponseJob(job, logs)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
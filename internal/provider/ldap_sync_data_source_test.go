@@ -0,0 +1,57 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+func TestLDAPSyncDataSourceDefault(t *testing.T) {
+	s := testAccFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Nothing has been synced yet
+			{
+				Config: testAccProviderConfig(s) + testLDAPSyncDataSource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.mke_ldap_sync.test", "id", ""),
+					resource.TestCheckResourceAttr("data.mke_ldap_sync.test", "error_count", "0"),
+				),
+			},
+			// A failed sync, reported with its errors
+			{
+				PreConfig: func() { testAccStartLDAPSync(t, s) },
+				Config:    testAccProviderConfig(s) + testLDAPSyncDataSource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.mke_ldap_sync.test", "id"),
+					resource.TestCheckResourceAttr("data.mke_ldap_sync.test", "status", client.JobStatusErrored),
+					resource.TestCheckResourceAttr("data.mke_ldap_sync.test", "error_count", "1"),
+					resource.TestCheckResourceAttr("data.mke_ldap_sync.test", "errors.0", "LDAP is not configured"),
+				),
+			},
+			// The latest sync is reported
+			{
+				PreConfig: func() {
+					testAccConfigureLDAP(t, s)
+					testAccStartLDAPSync(t, s)
+				},
+				Config: testAccProviderConfig(s) + testLDAPSyncDataSource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.mke_ldap_sync.test", "status", client.JobStatusDone),
+					resource.TestCheckResourceAttr("data.mke_ldap_sync.test", "error_count", "0"),
+					resource.TestCheckResourceAttr("data.mke_ldap_sync.test", "info_count", "2"),
+					resource.TestCheckResourceAttr("data.mke_ldap_sync.test", "errors.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testLDAPSyncDataSource = `
+	data "mke_ldap_sync" "test" {
+	}`
@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// LDAPSyncDefaultWaitTimeout how long to wait for a sync job when no wait_timeout is configured.
+	LDAPSyncDefaultWaitTimeout = "10m"
+)
+
+var (
+	// ldapSyncPollInterval how often a running sync job is checked.
+	ldapSyncPollInterval = time.Second
+)
+
+var _ resource.Resource = &LDAPSyncTriggerResource{}
+var _ resource.ResourceWithValidateConfig = &LDAPSyncTriggerResource{}
+
+type LDAPSyncTriggerResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Triggers    types.Map    `tfsdk:"triggers"`
+	WaitTimeout types.String `tfsdk:"wait_timeout"`
+	Status      types.String `tfsdk:"status"`
+	ScheduledAt types.String `tfsdk:"scheduled_at"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+// FromResponseJob populate the model from an eNZi sync job.
+func (m *LDAPSyncTriggerResourceModel) FromResponseJob(job client.ResponseJob) {
+	m.Id = types.StringValue(job.ID)
+	m.Status = types.StringValue(job.Status)
+	m.ScheduledAt = types.StringValue(job.ScheduledAt)
+	m.LastUpdated = types.StringValue(job.LastUpdated)
+}
+
+type LDAPSyncTriggerResource struct {
+	providerModel MKEProviderModel
+}
+
+func NewLDAPSyncTriggerResource() resource.Resource {
+	return &LDAPSyncTriggerResource{}
+}
+
+func (r *LDAPSyncTriggerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ldap_sync_trigger"
+}
+
+func (r *LDAPSyncTriggerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Runs an LDAP sync when created, or when `triggers` change, and waits for it to finish. " +
+			"Destroying the resource does nothing in MKE.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the sync job",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values which run a new sync when they change, e.g. the IDs of LDAP config resources",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for the sync to finish, as a duration such as `30s` or `10m`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(LDAPSyncDefaultWaitTimeout),
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the sync job",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scheduled_at": schema.StringAttribute{
+				MarkdownDescription: "When the sync job was scheduled",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				MarkdownDescription: "When the status of the sync job last changed",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *LDAPSyncTriggerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	lpm, ok := req.ProviderData.(MKEProviderModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *MKEProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	tflog.Debug(ctx, "Successfully interpeted provider model", map[string]interface{}{})
+	r.providerModel = lpm
+}
+
+// ValidateConfig check that the wait timeout is a duration.
+func (r *LDAPSyncTriggerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data LDAPSyncTriggerResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.WaitTimeout.IsNull() || data.WaitTimeout.IsUnknown() {
+		return
+	}
+
+	if _, err := time.ParseDuration(data.WaitTimeout.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("wait_timeout"), "Invalid wait timeout", err.Error())
+	}
+}
+
+func (r *LDAPSyncTriggerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_ldap_sync_trigger", "create")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data LDAPSyncTriggerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, err := time.ParseDuration(data.WaitTimeout.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("wait_timeout"), "Invalid wait timeout", err.Error())
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	job, err := cl.ApiLDAPSyncStart(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Start LDAP sync error", err.Error())
+		return
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	job, err = cl.ApiJobWait(waitCtx, job.ID, ldapSyncPollInterval)
+	if errors.Is(err, client.ErrJobFailed) {
+		// the job logs explain why the sync failed, so include its errors
+		resp.Diagnostics.AddError("LDAP sync failed", fmt.Sprintf("%s\n\n%s", err.Error(), r.jobErrors(ctx, cl, job.ID)))
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("LDAP sync error", err.Error())
+		return
+	}
+
+	data.FromResponseJob(job)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// jobErrors the error lines logged by a job, for diagnostics.
+func (r *LDAPSyncTriggerResource) jobErrors(ctx context.Context, cl client.Client, id string) string {
+	logs, err := cl.ApiJobLogs(ctx, id)
+	if err != nil {
+		return fmt.Sprintf("The job logs could not be read: %s", err.Error())
+	}
+
+	errs := []string{}
+	for _, l := range logs {
+		if l.Level == client.JobLogLevelError {
+			errs = append(errs, l.Msg)
+		}
+	}
+	return strings.Join(errs, "\n")
+}
+
+func (r *LDAPSyncTriggerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_ldap_sync_trigger", "read")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data LDAPSyncTriggerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	job, err := cl.ApiJobRead(ctx, data.Id.ValueString())
+	if client.IsNotFound(err) {
+		// old jobs are purged by MKE, which is not a reason to sync again, so keep the last known job state
+		tflog.Debug(ctx, "LDAP sync job no longer in MKE", map[string]any{"id": data.Id.ValueString()})
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Read LDAP sync error", err.Error())
+		return
+	}
+
+	data.FromResponseJob(job)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LDAPSyncTriggerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_ldap_sync_trigger", "update")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data LDAPSyncTriggerResourceModel
+
+	// only wait_timeout can change without a new sync, which only matters for the next sync
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LDAPSyncTriggerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	_, span := startOperationSpan(ctx, "mke_ldap_sync_trigger", "delete")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	// a sync can't be undone, so there is nothing to delete
+}
@@ -0,0 +1,109 @@
+package provider_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/Mirantis/terraform-provider-mke/internal/mketest"
+)
+
+func TestLDAPSyncTriggerResourceDefault(t *testing.T) {
+	s := testAccFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid timeouts are rejected before apply
+			{
+				Config:      testAccProviderConfig(s) + testLDAPSyncTriggerResource("1", `wait_timeout = "soon"`),
+				ExpectError: regexp.MustCompile("Invalid wait timeout"),
+			},
+			// A sync without LDAP settings fails, with the job errors
+			{
+				Config:      testAccProviderConfig(s) + testLDAPSyncTriggerResource("1", ""),
+				ExpectError: regexp.MustCompile("LDAP is not configured"),
+			},
+			// Create and Read testing
+			{
+				PreConfig: func() { testAccConfigureLDAP(t, s) },
+				Config:    testAccProviderConfig(s) + testLDAPSyncTriggerResource("1", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("mke_ldap_sync_trigger.test", "id"),
+					resource.TestCheckResourceAttr("mke_ldap_sync_trigger.test", "status", client.JobStatusDone),
+					testAccCheckLDAPSyncJobCount(s, 2),
+				),
+			},
+			// Changing only the timeout does not sync again
+			{
+				Config: testAccProviderConfig(s) + testLDAPSyncTriggerResource("1", `wait_timeout = "1m"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_ldap_sync_trigger.test", "wait_timeout", "1m"),
+					testAccCheckLDAPSyncJobCount(s, 2),
+				),
+			},
+			// Changing the triggers syncs again
+			{
+				Config: testAccProviderConfig(s) + testLDAPSyncTriggerResource("2", `wait_timeout = "1m"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_ldap_sync_trigger.test", "status", client.JobStatusDone),
+					testAccCheckLDAPSyncJobCount(s, 3),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testLDAPSyncTriggerResource(trigger, settings string) string {
+	return fmt.Sprintf(`
+	resource "mke_ldap_sync_trigger" "test" {
+		triggers = {
+			config = "%s"
+		}
+		%s
+	}`, trigger, settings)
+}
+
+// testAccConfigureLDAP set LDAP settings directly in the fake MKE server, so that syncs succeed.
+func testAccConfigureLDAP(t *testing.T, s *mketest.Server) {
+	c, _ := s.Client()
+	if _, err := c.ApiLDAPSettingsUpdate(context.Background(), client.LDAPSettings{
+		ServerURL:         "ldaps://ldap.example.com",
+		UserSearchConfigs: []client.LDAPUserSearchConfig{{BaseDN: "dc=example,dc=com", UsernameAttr: "uid"}},
+	}); err != nil {
+		t.Fatalf("could not configure LDAP: %s", err)
+	}
+}
+
+// testAccCheckLDAPSyncJobCount confirm how many LDAP sync jobs the fake MKE server has run.
+func testAccCheckLDAPSyncJobCount(s *mketest.Server, expected int) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if jobs := s.Jobs(client.JobActionLDAPSync); len(jobs) != expected {
+			return fmt.Errorf("MKE has %d LDAP sync jobs, expected %d", len(jobs), expected)
+		}
+		return nil
+	}
+}
+
+// testAccStartLDAPSync run an LDAP sync in the fake MKE server, outside of terraform.
+func testAccStartLDAPSync(t *testing.T, s *mketest.Server) {
+	ctx := context.Background()
+	c, _ := s.Client()
+
+	job, err := c.ApiLDAPSyncStart(ctx)
+	if err != nil {
+		t.Fatalf("could not start an LDAP sync: %s", err)
+	}
+	// a failed sync is still a finished sync
+	if _, err := c.ApiJobWait(ctx, job.ID, 0); err != nil && !errors.Is(err, client.ErrJobFailed) {
+		t.Fatalf("could not wait for the LDAP sync: %s", err)
+	}
+}
@@ -18,33 +18,6 @@ import (
 
 var (
 	ErrCBNotFound = errors.New("Client bundle was not found on the MKE host cluster")
-
-	// DummyClientBundle client bundle used during unit ACC tests
-	DummyClientBundle client.ClientBundle = client.ClientBundle{
-		ID: "id",
-
-		PrivateKey: "my-priv-key",
-		PublicKey:  "my-pub-key",
-		Cert:       "my-cert",
-		CACert:     "my-ca-cert",
-		Kube: &client.ClientBundleKube{
-			Config:   "my-kube-yaml",
-			Host:     "my-kube-host",
-			Insecure: "my-kube-insecure",
-		},
-		Meta: client.ClientBundleMeta{
-			Name:        "id",
-			Description: "my-cluster",
-
-			KubernetesHost:          "my-kube-host",
-			KubernetesSkipVerifyTLS: true,
-
-			DockerHost:          "my-docker-host",
-			DockerSkipVerifyTLS: true,
-
-			StackOrchestrator: "kubernetes",
-		},
-	}
 )
 
 // ClientBundleResourceModel describes the resource data model.
@@ -237,6 +210,9 @@ func (r *MKEClientBundleResource) Configure(ctx context.Context, req resource.Co
 }
 
 func (r *MKEClientBundleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_clientbundle", "create")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
 	var m ClientBundleResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &m)...)
@@ -251,29 +227,25 @@ func (r *MKEClientBundleResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	if r.providerModel.TestingMode() {
-		cb := DummyClientBundle
-		resp.Diagnostics.AddWarning("ClientBundle in testing mode", fmt.Sprintf("Client Bundle creation not executed because the resource is in testing mode: %s", cb.ToJSON()))
-
-		m.FromClientBundle(cb)
-	} else {
-		cb, err := cl.ApiClientBundleCreate(ctx, m.Label.ValueString())
-		if err != nil {
-			resp.Diagnostics.AddError("MKE client could not create a client bundle", fmt.Sprintf("An error occurred creating the client bundle: %s", err.Error()))
-			return
-		}
+	cb, err := cl.ApiClientBundleCreate(ctx, m.Label.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("MKE client could not create a client bundle", fmt.Sprintf("An error occurred creating the client bundle: %s", err.Error()))
+		return
+	}
 
-		resp.Diagnostics.Append(m.FromClientBundle(cb)...)
-		if resp.Diagnostics.HasError() {
-			tflog.Error(ctx, "Failed to convert ClientBundle response from the API into the ClientBundle models", map[string]interface{}{})
-			return
-		}
+	resp.Diagnostics.Append(m.FromClientBundle(cb)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Failed to convert ClientBundle response from the API into the ClientBundle models", map[string]interface{}{})
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, m)...)
 }
 
 func (r *MKEClientBundleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_clientbundle", "read")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
 	var m ClientBundleResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &m)...)
@@ -297,26 +269,30 @@ func (r *MKEClientBundleResource) Read(ctx context.Context, req resource.ReadReq
 		resp.Diagnostics.AddError("Failed to convert Client Bundle Model", "Could not interpret plan Client Bundle model into client ClientBundle")
 		return
 	}
-	if r.providerModel.TestingMode() {
-		resp.Diagnostics.AddWarning("ClientBundle in testing mode", "ClientBundle read/confirm not executed because the resource is in testing mode")
-		return
-	}
 	if _, err := cl.ApiClientBundleGetPublicKey(ctx, cb); err != nil {
-		if errors.Is(err, client.ErrFailedToFindClientBundleMKEPublicKey) {
+		if client.IsNotFound(err) {
 			// we have a bundle in state, but it doesn't exist in MKE so it should be removed
-			// @todo check that we haven't suffered from a connectivity failure
 			resp.Diagnostics.AddWarning("Client Bundle in state not found in MKE API", fmt.Errorf("%w; %s", ErrCBNotFound, err).Error())
 			resp.State.RemoveResource(ctx)
+			return
 		}
+
+		resp.Diagnostics.AddError("Failed to read Client Bundle", fmt.Sprintf("MKE Client could not confirm the client bundle: %s", err.Error()))
 	}
 }
 
 func (r *MKEClientBundleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_clientbundle", "update")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
 	// There is no option to update a client bundle, but at the same time there is no schema that can be changed, so an update shouldn't be needed.
 	// There is an issue of expiry options on client bundles, but this is a new feature in MKE that wasn't rolled out 2023/08
 }
 
 func (r *MKEClientBundleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_clientbundle", "delete")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
 	var m ClientBundleResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &m)...)
@@ -337,15 +313,11 @@ func (r *MKEClientBundleResource) Delete(ctx context.Context, req resource.Delet
 		resp.Diagnostics.AddError("Failed to convert Client Bundle Model", "Could not interpret plan Client Bundle model into client ClientBundle")
 	}
 
-	if r.providerModel.TestingMode() {
-		resp.Diagnostics.AddWarning("ClientBundle in testing mode", "ClientBundle deletion not executed because the resource is in testing mode")
-		resp.Diagnostics.Append(resp.State.Set(ctx, m)...)
-	} else {
-
-		if err := cl.ApiClientBundleDelete(ctx, cb); err != nil {
-			resp.Diagnostics.AddError("Failed to delete Client Bundle", fmt.Sprintf("MKE Client could not delete the client bundle: %s", err.Error()))
-			return
-		}
+	if err := cl.ApiClientBundleDelete(ctx, cb); client.IsNotFound(err) {
+		tflog.Debug(ctx, "Client bundle was already removed from MKE", map[string]interface{}{"id": cb.ID})
+	} else if err != nil {
+		resp.Diagnostics.AddError("Failed to delete Client Bundle", fmt.Sprintf("MKE Client could not delete the client bundle: %s", err.Error()))
+		return
 	}
 
 	// Remove resource from state
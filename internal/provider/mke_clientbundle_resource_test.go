@@ -1,13 +1,17 @@
 package provider_test
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	fr_resource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/Mirantis/terraform-provider-mke/internal/mketest"
 	"github.com/Mirantis/terraform-provider-mke/internal/provider"
 )
 
@@ -96,19 +100,38 @@ func TestModelToClientBundle(t *testing.T) {
 }
 
 func TestAccMKEClientBundleResource(t *testing.T) {
+	s := testAccFakeServer(t)
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckClientBundleKeyCount(s, 0),
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccMKEClientBundleResource_minimal(),
+				Config: testAccProviderConfig(s) + testAccMKEClientBundleResource_minimal(),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("mke_clientbundle.test", "label", "my client bundle"),
-					resource.TestCheckResourceAttr("mke_clientbundle.test", "id", provider.DummyClientBundle.ID),
-					resource.TestCheckResourceAttr("mke_clientbundle.test", "kube_yaml", provider.DummyClientBundle.Kube.Config),
-					resource.TestCheckResourceAttr("mke_clientbundle.test", "ca_cert", provider.DummyClientBundle.CACert),
-					resource.TestCheckResourceAttr("mke_clientbundle.test", "kube_host", provider.DummyClientBundle.Kube.Host),
+					resource.TestCheckResourceAttrSet("mke_clientbundle.test", "id"),
+					resource.TestCheckResourceAttrSet("mke_clientbundle.test", "kube_yaml"),
+					resource.TestCheckResourceAttrSet("mke_clientbundle.test", "ca_cert"),
+					resource.TestCheckResourceAttrSet("mke_clientbundle.test", "private_key"),
+					resource.TestCheckResourceAttrSet("mke_clientbundle.test", "kube_host"),
+					resource.TestCheckResourceAttr("mke_clientbundle.test", "orchestrator", "kubernetes"),
+					testAccCheckClientBundleKeyCount(s, 1),
+				),
+			},
+			// Key removed outside of terraform, so a new bundle is created
+			{
+				PreConfig: func() {
+					c, _ := s.Client()
+					for _, k := range s.PublicKeys(mketest.DefaultAdminUsername) {
+						c.ApiPublicKeyDelete(context.Background(), mketest.DefaultAdminUsername, k.ID) //nolint:errcheck
+					}
+				},
+				Config: testAccProviderConfig(s) + testAccMKEClientBundleResource_minimal(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClientBundleKeyCount(s, 1),
 				),
 			},
 		},
@@ -117,14 +140,18 @@ func TestAccMKEClientBundleResource(t *testing.T) {
 
 func testAccMKEClientBundleResource_minimal() string {
 	return `
-provider "mke" {
-    endpoint = "https://my.mke.test"
-    username = "user"
-    password = "password"
-}
-
 resource "mke_clientbundle" "test" {
     label = "my client bundle"
 }
 `
 }
+
+// testAccCheckClientBundleKeyCount confirm how many client bundle keys the admin has in the fake MKE server.
+func testAccCheckClientBundleKeyCount(s *mketest.Server, count int) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if keys := s.PublicKeys(mketest.DefaultAdminUsername); len(keys) != count {
+			return fmt.Errorf("expected %d client bundle keys in MKE, found %d", count, len(keys))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &OrgMemberResource{}
+var _ resource.ResourceWithImportState = &OrgMemberResource{}
+
+type OrgMemberResourceModel struct {
+	Id      types.String `tfsdk:"id"`
+	Org     types.String `tfsdk:"org"`
+	User    types.String `tfsdk:"user"`
+	IsAdmin types.Bool   `tfsdk:"is_admin"`
+}
+
+type OrgMemberResource struct {
+	providerModel MKEProviderModel
+}
+
+func NewOrgMemberResource() resource.Resource {
+	return &OrgMemberResource{}
+}
+
+func (r *OrgMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org_member"
+}
+
+func (r *OrgMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Organization membership of a single user, optionally as an org admin. " +
+			"MKE also adds users to an org implicitly when they join one of its teams. " +
+			"If the user is still in any team of the org when this resource is destroyed, " +
+			"the user stays in the org and only the org admin role is removed.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier, as `org/user`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org": schema.StringAttribute{
+				MarkdownDescription: "The name of the organization",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "The name of the user",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_admin": schema.BoolAttribute{
+				MarkdownDescription: "Is the user an org admin",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *OrgMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	lpm, ok := req.ProviderData.(MKEProviderModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *MKEProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	tflog.Debug(ctx, "Successfully interpeted provider model", map[string]interface{}{})
+	r.providerModel = lpm
+}
+
+func (r *OrgMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_org_member", "create")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data OrgMemberResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	// the user may already be an implicit member through a team, in which case the membership is adopted
+	rMember, err := cl.ApiOrgMemberSet(ctx, data.Org.ValueString(), data.User.ValueString(), data.IsAdmin.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Create org member error", err.Error())
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", data.Org.ValueString(), data.User.ValueString()))
+	data.IsAdmin = types.BoolValue(rMember.IsAdmin)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrgMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_org_member", "read")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data OrgMemberResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	rMember, err := cl.ApiOrgMemberRead(ctx, data.Org.ValueString(), data.User.ValueString())
+	if client.IsNotFound(err) {
+		// the membership was removed outside of terraform, so it should be removed from state
+		resp.Diagnostics.AddWarning("Org member in state not found in MKE API", err.Error())
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Read org member error", err.Error())
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", data.Org.ValueString(), data.User.ValueString()))
+	data.IsAdmin = types.BoolValue(rMember.IsAdmin)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrgMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_org_member", "update")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data OrgMemberResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	rMember, err := cl.ApiOrgMemberSet(ctx, data.Org.ValueString(), data.User.ValueString(), data.IsAdmin.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Update org member error", err.Error())
+		return
+	}
+
+	data.IsAdmin = types.BoolValue(rMember.IsAdmin)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, "Updated 'org_member' resource", map[string]any{"success": true})
+}
+
+func (r *OrgMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_org_member", "delete")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data OrgMemberResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	org, user := data.Org.ValueString(), data.User.ValueString()
+
+	teams, err := cl.ApiOrgMemberTeams(ctx, org, user)
+	if client.IsNotFound(err) {
+		tflog.Debug(ctx, "Org member was already removed from MKE", map[string]any{"id": data.Id.ValueString()})
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Delete org member error", err.Error())
+		return
+	}
+
+	// removing the org membership would also remove the user from its teams, which are managed elsewhere
+	if len(teams) > 0 {
+		teamNames := []string{}
+		for _, t := range teams {
+			teamNames = append(teamNames, t.Name)
+		}
+
+		if _, err := cl.ApiOrgMemberSet(ctx, org, user, false); err != nil {
+			resp.Diagnostics.AddError("Delete org member error", err.Error())
+			return
+		}
+
+		resp.Diagnostics.AddWarning(
+			"Org member left in org",
+			fmt.Sprintf("User %s is still a member of teams %s in org %s, so only the org admin role was removed.", user, strings.Join(teamNames, ", "), org),
+		)
+		return
+	}
+
+	if err := cl.ApiOrgMemberRemove(ctx, org, user); client.IsNotFound(err) {
+		tflog.Debug(ctx, "Org member was already removed from MKE", map[string]any{"id": data.Id.ValueString()})
+	} else if err != nil {
+		resp.Diagnostics.AddError("Delete org member error", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted org_member resource", map[string]any{"success": true})
+}
+
+// ImportState org members are imported with an `org/user` ID.
+func (r *OrgMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, ok := splitImportID(req.ID, 2)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected import identifier", fmt.Sprintf("Expected an import identifier like `org/user`, got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user"), parts[1])...)
+}
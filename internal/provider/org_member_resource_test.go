@@ -0,0 +1,125 @@
+package provider_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/Mirantis/terraform-provider-mke/internal/mketest"
+)
+
+func TestOrgMemberResourceDefault(t *testing.T) {
+	s := testAccFakeServer(t)
+	s.CreateAccount(client.CreateAccount{Name: "user1", Password: "password", IsActive: true})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(s) + testOrgMemberResource(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_org_member.test", "id", "testorg/user1"),
+					resource.TestCheckResourceAttr("mke_org_member.test", "is_admin", "true"),
+					testAccCheckOrgMembersInMKE(s, "testorg", map[string]bool{"user1": true}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "mke_org_member.test",
+				ImportState:       true,
+				ImportStateId:     "testorg/user1",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(s) + testOrgMemberResource(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_org_member.test", "is_admin", "false"),
+					testAccCheckOrgMembersInMKE(s, "testorg", map[string]bool{"user1": false}),
+				),
+			},
+			// Removed outside of terraform, so it is added again
+			{
+				PreConfig: func() { s.RemoveOrgMember("testorg", "user1") },
+				Config:    testAccProviderConfig(s) + testOrgMemberResource(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrgMembersInMKE(s, "testorg", map[string]bool{"user1": true}),
+				),
+			},
+			// A user still in an org team stays in the org, but loses the org admin role
+			{
+				PreConfig: func() {
+					s.CreateTeam("testorg", client.CreateTeam{Name: "testteam"})
+					s.SetTeamMember("testorg", "testteam", "user1", false)
+				},
+				Config: testAccProviderConfig(s) + `
+				resource "mke_org" "test" {
+					name = "testorg"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrgMembersInMKE(s, "testorg", map[string]bool{"user1": false}),
+				),
+			},
+		},
+	})
+}
+
+func TestOrgMemberResourceAdoptsImplicitMember(t *testing.T) {
+	s := testAccFakeServer(t)
+	s.CreateAccount(client.CreateAccount{Name: "user1", Password: "password", IsActive: true})
+	s.CreateAccount(client.CreateAccount{Name: "testorg", IsOrg: true})
+	s.CreateTeam("testorg", client.CreateTeam{Name: "testteam"})
+	s.SetTeamMember("testorg", "testteam", "user1", false)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(s) + `
+				resource "mke_org_member" "test" {
+					org = "testorg"
+					user = "user1"
+					is_admin = true
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrgMembersInMKE(s, "testorg", map[string]bool{"user1": true}),
+					testAccCheckTeamMembersInMKE(s, "testorg", "testteam", map[string]bool{"user1": false}),
+				),
+			},
+		},
+	})
+}
+
+func testOrgMemberResource(isAdmin bool) string {
+	return fmt.Sprintf(`
+	resource "mke_org" "test" {
+		name = "testorg"
+	}
+
+	resource "mke_org_member" "test" {
+		org = mke_org.test.name
+		user = "user1"
+		is_admin = %t
+	}`, isAdmin)
+}
+
+// testAccCheckOrgMembersInMKE confirm that the org in the fake MKE server has exactly the expected members.
+func testAccCheckOrgMembersInMKE(s *mketest.Server, org string, expected map[string]bool) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		members, ok := s.OrgMembers(org)
+		if !ok {
+			return fmt.Errorf("org %s does not exist in MKE", org)
+		}
+		if !reflect.DeepEqual(members, expected) {
+			return fmt.Errorf("org %s has members %v, expected %v", org, members, expected)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,244 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &OrgResource{}
+var _ resource.ResourceWithImportState = &OrgResource{}
+
+type OrgResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	FullName     types.String `tfsdk:"full_name"`
+	MembersCount types.Int64  `tfsdk:"members_count"`
+	TeamsCount   types.Int64  `tfsdk:"teams_count"`
+}
+
+// FromResponseAccount populate the model from an eNZi org account.
+func (m *OrgResourceModel) FromResponseAccount(acc client.ResponseAccount) {
+	m.Id = types.StringValue(acc.ID)
+	m.Name = types.StringValue(acc.Name)
+	m.FullName = types.StringValue(acc.FullName)
+	m.MembersCount = types.Int64Value(int64(acc.MembersCount))
+	m.TeamsCount = types.Int64Value(int64(acc.TeamsCount))
+}
+
+type OrgResource struct {
+	providerModel MKEProviderModel
+}
+
+func NewOrgResource() resource.Resource {
+	return &OrgResource{}
+}
+
+func (r *OrgResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org"
+}
+
+func (r *OrgResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Organization resource, for grouping users and teams in MKE.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the organization. Changing the name creates a new organization.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 100)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"full_name": schema.StringAttribute{
+				MarkdownDescription: "The full name of the organization",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"members_count": schema.Int64Attribute{
+				MarkdownDescription: "How many users are members of the organization",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"teams_count": schema.Int64Attribute{
+				MarkdownDescription: "How many teams the organization has",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OrgResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	lpm, ok := req.ProviderData.(MKEProviderModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *MKEProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	tflog.Debug(ctx, "Successfully interpeted provider model", map[string]interface{}{})
+	r.providerModel = lpm
+}
+
+func (r *OrgResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_org", "create")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data OrgResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	org := client.CreateOrg{
+		Name:     data.Name.ValueString(),
+		FullName: data.FullName.ValueString(),
+	}
+
+	rOrg, err := cl.ApiCreateOrg(ctx, org)
+	if err != nil {
+		resp.Diagnostics.AddError("Create org error", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created Org resource `%s`", data.Name.ValueString()))
+
+	data.FromResponseAccount(rOrg)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrgResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_org", "read")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data OrgResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	rOrg, err := cl.ApiReadOrg(ctx, data.Name.ValueString())
+	if client.IsNotFound(err) {
+		// the org was removed outside of terraform, so it should be removed from state
+		resp.Diagnostics.AddWarning("Org in state not found in MKE API", err.Error())
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Read org error", err.Error())
+		return
+	}
+
+	data.FromResponseAccount(rOrg)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrgResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_org", "update")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data OrgResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	rOrg, err := cl.ApiUpdateOrg(ctx, data.Name.ValueString(), client.UpdateOrg{FullName: data.FullName.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Update org error", err.Error())
+		return
+	}
+
+	data.FromResponseAccount(rOrg)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, "Updated 'org' resource", map[string]any{"success": true})
+}
+
+func (r *OrgResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_org", "delete")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data OrgResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	if err := cl.ApiDeleteOrg(ctx, data.Name.ValueString()); client.IsNotFound(err) {
+		tflog.Debug(ctx, "Org was already removed from MKE", map[string]any{"name": data.Name.ValueString()})
+	} else if err != nil {
+		resp.Diagnostics.AddError("Delete org error", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted org resource", map[string]any{"success": true})
+}
+
+func (r *OrgResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
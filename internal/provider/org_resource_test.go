@@ -0,0 +1,83 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/mketest"
+)
+
+func TestOrgResourceDefault(t *testing.T) {
+	s := testAccFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroyed(s, "testorg"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(s) + testOrgResource("Test Org"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_org.test", "name", "testorg"),
+					resource.TestCheckResourceAttr("mke_org.test", "full_name", "Test Org"),
+					resource.TestCheckResourceAttr("mke_org.test", "members_count", "0"),
+					resource.TestCheckResourceAttrSet("mke_org.test", "id"),
+					testAccCheckOrgInMKE(s, "testorg", "Test Org"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "mke_org.test",
+				ImportState:       true,
+				ImportStateId:     "testorg",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(s) + testOrgResource("Renamed Org"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_org.test", "full_name", "Renamed Org"),
+					testAccCheckOrgInMKE(s, "testorg", "Renamed Org"),
+				),
+			},
+			// Removed outside of terraform, so it is recreated
+			{
+				PreConfig: func() { s.RemoveAccount("testorg") },
+				Config:    testAccProviderConfig(s) + testOrgResource("Renamed Org"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrgInMKE(s, "testorg", "Renamed Org"),
+				),
+			},
+			// Delete is called implicitly
+		},
+	})
+}
+
+func testOrgResource(fullName string) string {
+	return fmt.Sprintf(`
+	resource "mke_org" "test" {
+		name = "testorg"
+		full_name = %q
+	}`, fullName)
+}
+
+// testAccCheckOrgInMKE confirm that the fake MKE server has the expected org account.
+func testAccCheckOrgInMKE(s *mketest.Server, name, fullName string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		acc, ok := s.Account(name)
+		if !ok {
+			return fmt.Errorf("org %s does not exist in MKE", name)
+		}
+		if !acc.IsOrg {
+			return fmt.Errorf("account %s is not an org", name)
+		}
+		if acc.FullName != fullName {
+			return fmt.Errorf("org %s has full name %q, expected %q", name, acc.FullName, fullName)
+		}
+		return nil
+	}
+}
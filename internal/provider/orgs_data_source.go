@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &OrgsDataSource{}
+
+type OrgsDataSourceModel struct {
+	Orgs []OrgsDataSourceOrgModel `tfsdk:"orgs"`
+}
+
+type OrgsDataSourceOrgModel struct {
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	FullName     types.String `tfsdk:"full_name"`
+	MembersCount types.Int64  `tfsdk:"members_count"`
+	TeamsCount   types.Int64  `tfsdk:"teams_count"`
+}
+
+// FromResponseAccount populate the model from an MKE organization account.
+func (m *OrgsDataSourceOrgModel) FromResponseAccount(acc client.ResponseAccount) {
+	m.Id = types.StringValue(acc.ID)
+	m.Name = types.StringValue(acc.Name)
+	m.FullName = types.StringValue(acc.FullName)
+	m.MembersCount = types.Int64Value(int64(acc.MembersCount))
+	m.TeamsCount = types.Int64Value(int64(acc.TeamsCount))
+}
+
+type OrgsDataSource struct {
+	providerModel MKEProviderModel
+}
+
+func NewOrgsDataSource() datasource.DataSource {
+	return &OrgsDataSource{}
+}
+
+func (d *OrgsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_orgs"
+}
+
+func (d *OrgsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "All of the MKE organizations, sorted by name.",
+
+		Attributes: map[string]schema.Attribute{
+			"orgs": schema.ListNestedAttribute{
+				MarkdownDescription: "The organizations",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the organization",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the organization",
+							Computed:            true,
+						},
+						"full_name": schema.StringAttribute{
+							MarkdownDescription: "The full name of the organization",
+							Computed:            true,
+						},
+						"members_count": schema.Int64Attribute{
+							MarkdownDescription: "How many members the organization has",
+							Computed:            true,
+						},
+						"teams_count": schema.Int64Attribute{
+							MarkdownDescription: "How many teams the organization has",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OrgsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	lpm, ok := req.ProviderData.(MKEProviderModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *MKEProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	tflog.Debug(ctx, "Successfully interpeted provider model", map[string]interface{}{})
+	d.providerModel = lpm
+}
+
+func (d *OrgsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_orgs", "read")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data OrgsDataSourceModel
+
+	cl, err := d.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	accs, err := cl.ApiOrgList(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("List orgs error", err.Error())
+		return
+	}
+
+	data.Orgs = []OrgsDataSourceOrgModel{}
+	for _, acc := range accs {
+		if !acc.IsOrg {
+			continue
+		}
+		var org OrgsDataSourceOrgModel
+		org.FromResponseAccount(acc)
+		data.Orgs = append(data.Orgs, org)
+	}
+	sort.Slice(data.Orgs, func(i, j int) bool { return data.Orgs[i].Name.ValueString() < data.Orgs[j].Name.ValueString() })
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
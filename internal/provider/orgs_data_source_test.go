@@ -0,0 +1,35 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+func TestOrgsDataSourceDefault(t *testing.T) {
+	s := testAccFakeServer(t)
+	s.CreateAccount(client.CreateAccount{Name: "zeta", IsOrg: true})
+	s.CreateAccount(client.CreateAccount{Name: "alpha", FullName: "Alpha Org", IsOrg: true})
+	s.CreateTeam("alpha", client.CreateTeam{Name: "devs"})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Only organizations, sorted by name
+			{
+				Config: testAccProviderConfig(s) + `data "mke_orgs" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.mke_orgs.test", "orgs.#", "2"),
+					resource.TestCheckResourceAttr("data.mke_orgs.test", "orgs.0.name", "alpha"),
+					resource.TestCheckResourceAttr("data.mke_orgs.test", "orgs.0.full_name", "Alpha Org"),
+					resource.TestCheckResourceAttr("data.mke_orgs.test", "orgs.0.teams_count", "1"),
+					resource.TestCheckResourceAttrSet("data.mke_orgs.test", "orgs.0.id"),
+					resource.TestCheckResourceAttr("data.mke_orgs.test", "orgs.1.name", "zeta"),
+				),
+			},
+		},
+	})
+}
@@ -15,10 +15,6 @@ import (
 const (
 	// ProviderName name for the provider in the Mirantis namespace.
 	ProviderName = "mke"
-
-	// TestingVersion if the provider version is this, then the provider
-	//   will start in testing mode, where it never reaches the API.
-	TestingVersion = "test"
 )
 
 func New(version string) func() provider.Provider {
@@ -76,30 +72,43 @@ func (p *MKEProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		return
 	}
 
-	if p.version == TestingVersion {
-		model.testingMode = types.BoolValue(true)
-	}
-
 	resp.ResourceData = model
 	resp.DataSourceData = model
-
 }
 
 func (p *MKEProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewMKEClientBundleResource,
 		NewUserResource,
+		NewOrgResource,
+		NewOrgMemberResource,
+		NewTeamResource,
+		NewTeamMembersResource,
+		NewTeamMemberResource,
+		NewTeamLDAPSyncResource,
+		NewLDAPConfigResource,
+		NewSAMLConfigResource,
+		NewSCIMConfigResource,
+		NewSCIMTokenResource,
+		NewLDAPSyncTriggerResource,
+		NewAccountPublicKeyResource,
+		NewUsersResource,
 	}
 }
 
 func (p *MKEProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewLDAPSyncDataSource,
+		NewUserDataSource,
+		NewUsersDataSource,
+		NewOrgsDataSource,
+		NewTeamsDataSource,
+		NewUserMembershipsDataSource,
+	}
 }
 
 // MKEProviderModel describes the provider data model.
 type MKEProviderModel struct {
-	testingMode types.Bool
-
 	Endpoint  types.String `tfsdk:"endpoint"`
 	Username  types.String `tfsdk:"username"`
 	Password  types.String `tfsdk:"password"`
@@ -113,8 +122,3 @@ func (pm MKEProviderModel) Client() (client.Client, error) {
 	}
 	return client.NewClientSimple(pm.Endpoint.ValueString(), pm.Username.ValueString(), pm.Password.ValueString())
 }
-
-// TestingMode is the provider in testing mode?
-func (pm MKEProviderModel) TestingMode() bool {
-	return pm.testingMode.ValueBool()
-}
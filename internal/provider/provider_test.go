@@ -1,21 +1,28 @@
 package provider_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 
+	"github.com/Mirantis/terraform-provider-mke/internal/mketest"
 	mke_provider "github.com/Mirantis/terraform-provider-mke/internal/provider"
 )
 
+const (
+	// testProviderVersion version reported by the provider in acceptance tests.
+	testProviderVersion = "test"
+)
+
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
 // acceptance testing. The factory function will be invoked for every Terraform
 // CLI command executed to create a provider server to which the CLI can
 // reattach.
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-	"mke": providerserver.NewProtocol6WithError(mke_provider.New(mke_provider.TestingVersion)()),
+	"mke": providerserver.NewProtocol6WithError(mke_provider.New(testProviderVersion)()),
 }
 
 func testAccPreCheck(t *testing.T) {
@@ -25,6 +32,29 @@ func testAccPreCheck(t *testing.T) {
 
 }
 
+// testAccFakeServer start a fake MKE server for an acceptance test, which is closed when the test ends.
+func testAccFakeServer(t *testing.T) *mketest.Server {
+	s := mketest.NewServer()
+	t.Cleanup(s.Close)
+	return s
+}
+
+// testAccProviderConfig provider block which uses the fake server as its endpoint.
+func testAccProviderConfig(s *mketest.Server) string {
+	return testAccProviderConfigFor(s, mketest.DefaultAdminUsername, mketest.DefaultAdminPassword)
+}
+
+// testAccProviderConfigFor provider block which uses the fake server as its endpoint, logged in as another account.
+func testAccProviderConfigFor(s *mketest.Server, username, password string) string {
+	return fmt.Sprintf(`
+provider "mke" {
+	endpoint = %q
+	username = %q
+	password = %q
+}
+`, s.URL(), username, password)
+}
+
 func TestProviderSanity(t *testing.T) {
 	// Ensure Provider satisfies various provider interfaces.
 	var _ provider.Provider = &mke_provider.MKEProvider{}
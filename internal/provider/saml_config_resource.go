@@ -0,0 +1,322 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// SAMLConfigID the ID of the singleton SAML config.
+	SAMLConfigID = "saml"
+)
+
+var _ resource.Resource = &SAMLConfigResource{}
+var _ resource.ResourceWithImportState = &SAMLConfigResource{}
+
+type SAMLConfigResourceModel struct {
+	Id             types.String           `tfsdk:"id"`
+	Enabled        types.Bool             `tfsdk:"enabled"`
+	IdPMetadataURL types.String           `tfsdk:"idp_metadata_url"`
+	SPHost         types.String           `tfsdk:"sp_host"`
+	RootCerts      types.String           `tfsdk:"root_certs"`
+	TLSSkipVerify  types.Bool             `tfsdk:"tls_skip_verify"`
+	SPMetadata     types.String           `tfsdk:"sp_metadata"`
+	TeamMapping    []SAMLTeamMappingModel `tfsdk:"team_mapping"`
+}
+
+type SAMLTeamMappingModel struct {
+	AttributeName  types.String `tfsdk:"attribute_name"`
+	AttributeValue types.String `tfsdk:"attribute_value"`
+	Org            types.String `tfsdk:"org"`
+	Team           types.String `tfsdk:"team"`
+}
+
+// SAMLSettings convert the model to client SAML settings.
+func (m SAMLConfigResourceModel) SAMLSettings() client.SAMLSettings {
+	settings := client.SAMLSettings{
+		Enabled:        m.Enabled.ValueBool(),
+		IdPMetadataURL: m.IdPMetadataURL.ValueString(),
+		SPHost:         m.SPHost.ValueString(),
+		RootCerts:      m.RootCerts.ValueString(),
+		TLSSkipVerify:  m.TLSSkipVerify.ValueBool(),
+		TeamMappings:   []client.SAMLTeamMapping{},
+	}
+
+	for _, tm := range m.TeamMapping {
+		settings.TeamMappings = append(settings.TeamMappings, client.SAMLTeamMapping{
+			AttributeName:  tm.AttributeName.ValueString(),
+			AttributeValue: tm.AttributeValue.ValueString(),
+			Org:            tm.Org.ValueString(),
+			Team:           tm.Team.ValueString(),
+		})
+	}
+
+	return settings
+}
+
+// FromSAMLSettings populate the model from client SAML settings and the service provider metadata.
+func (m *SAMLConfigResourceModel) FromSAMLSettings(settings client.SAMLSettings, spMetadata string) {
+	m.Id = types.StringValue(SAMLConfigID)
+	m.Enabled = types.BoolValue(settings.Enabled)
+	m.IdPMetadataURL = types.StringValue(settings.IdPMetadataURL)
+	m.SPHost = types.StringValue(settings.SPHost)
+	m.RootCerts = types.StringValue(settings.RootCerts)
+	m.TLSSkipVerify = types.BoolValue(settings.TLSSkipVerify)
+	m.SPMetadata = types.StringValue(spMetadata)
+
+	m.TeamMapping = []SAMLTeamMappingModel{}
+	for _, tm := range settings.TeamMappings {
+		m.TeamMapping = append(m.TeamMapping, SAMLTeamMappingModel{
+			AttributeName:  types.StringValue(tm.AttributeName),
+			AttributeValue: types.StringValue(tm.AttributeValue),
+			Org:            types.StringValue(tm.Org),
+			Team:           types.StringValue(tm.Team),
+		})
+	}
+}
+
+type SAMLConfigResource struct {
+	providerModel MKEProviderModel
+}
+
+func NewSAMLConfigResource() resource.Resource {
+	return &SAMLConfigResource{}
+}
+
+func (r *SAMLConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_saml_config"
+}
+
+func (r *SAMLConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "SAML single sign-on settings of the cluster. " +
+			"There is only one SAML config per cluster, so only declare this resource once. " +
+			"Destroying the resource leaves the settings in place on the cluster.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier, always `saml`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Allow users to log in to MKE with SAML",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"idp_metadata_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the identity provider SAML metadata",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"sp_host": schema.StringAttribute{
+				MarkdownDescription: "The public URL of MKE, as the SAML service provider, e.g. `https://mke.example.com`",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"root_certs": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded root CA certificates used to verify the identity provider metadata URL",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"tls_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip verification of the identity provider metadata URL certificate. Use only for development systems",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"sp_metadata": schema.StringAttribute{
+				MarkdownDescription: "The service provider metadata XML of MKE, for registering MKE with the identity provider",
+				Computed:            true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"team_mapping": schema.ListNestedBlock{
+				MarkdownDescription: "Add users to an org, or to a team in the org, when a SAML assertion attribute has a value",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"attribute_name": schema.StringAttribute{
+							MarkdownDescription: "The SAML assertion attribute, e.g. `groups`",
+							Required:            true,
+							Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+						},
+						"attribute_value": schema.StringAttribute{
+							MarkdownDescription: "The value of the attribute which users must have",
+							Required:            true,
+							Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+						},
+						"org": schema.StringAttribute{
+							MarkdownDescription: "The name of the org the users are added to",
+							Required:            true,
+							Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+						},
+						"team": schema.StringAttribute{
+							MarkdownDescription: "The name of the team in the org the users are added to. Leave empty to only add org membership",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *SAMLConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	lpm, ok := req.ProviderData.(MKEProviderModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *MKEProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	tflog.Debug(ctx, "Successfully interpeted provider model", map[string]interface{}{})
+	r.providerModel = lpm
+}
+
+func (r *SAMLConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_saml_config", "create")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data SAMLConfigResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	rSettings, err := cl.ApiSAMLSettingsUpdate(ctx, data.SAMLSettings())
+	if err != nil {
+		resp.Diagnostics.AddError("Create SAML config error", err.Error())
+		return
+	}
+
+	spMetadata, err := cl.ApiSAMLMetadata(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Create SAML config error", err.Error())
+		return
+	}
+
+	data.FromSAMLSettings(rSettings, spMetadata)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SAMLConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_saml_config", "read")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data SAMLConfigResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	rSettings, err := cl.ApiSAMLSettingsRead(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Read SAML config error", err.Error())
+		return
+	}
+
+	// there is no metadata until a service provider host is set, e.g. when importing an empty config
+	spMetadata, err := cl.ApiSAMLMetadata(ctx)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Read SAML config error", err.Error())
+		return
+	}
+
+	data.FromSAMLSettings(rSettings, spMetadata)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SAMLConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_saml_config", "update")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data SAMLConfigResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	rSettings, err := cl.ApiSAMLSettingsUpdate(ctx, data.SAMLSettings())
+	if err != nil {
+		resp.Diagnostics.AddError("Update SAML config error", err.Error())
+		return
+	}
+
+	spMetadata, err := cl.ApiSAMLMetadata(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Update SAML config error", err.Error())
+		return
+	}
+
+	data.FromSAMLSettings(rSettings, spMetadata)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, "Updated 'saml_config' resource", map[string]any{"success": true})
+}
+
+func (r *SAMLConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	_, span := startOperationSpan(ctx, "mke_saml_config", "delete")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	// removing the SAML settings would lock SAML users out of the cluster, so they are left in place
+	resp.Diagnostics.AddWarning(
+		"SAML config left in MKE",
+		"The SAML settings have been removed from the terraform state, but are still configured in MKE.",
+	)
+}
+
+func (r *SAMLConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
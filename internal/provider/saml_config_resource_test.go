@@ -0,0 +1,109 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/Mirantis/terraform-provider-mke/internal/mketest"
+)
+
+func TestSAMLConfigResourceDefault(t *testing.T) {
+	s := testAccFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(s) + testSAMLConfigResource("https://mke.example.com", `
+					team_mapping {
+						attribute_name = "groups"
+						attribute_value = "devs"
+						org = "engineering"
+						team = "devs"
+					}
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_saml_config.test", "id", "saml"),
+					resource.TestCheckResourceAttr("mke_saml_config.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("mke_saml_config.test", "team_mapping.#", "1"),
+					resource.TestCheckResourceAttrWith("mke_saml_config.test", "sp_metadata", testAccCheckSAMLMetadata(s)),
+					testAccCheckSAMLTeamMappings(s, []client.SAMLTeamMapping{
+						{AttributeName: "groups", AttributeValue: "devs", Org: "engineering", Team: "devs"},
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "mke_saml_config.test",
+				ImportState:       true,
+				ImportStateId:     "saml",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing, with an org only mapping and a new service provider host
+			{
+				Config: testAccProviderConfig(s) + testSAMLConfigResource("https://mke2.example.com", `
+					team_mapping {
+						attribute_name = "groups"
+						attribute_value = "engineers"
+						org = "engineering"
+					}
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_saml_config.test", "team_mapping.0.team", ""),
+					resource.TestCheckResourceAttrWith("mke_saml_config.test", "sp_metadata", testAccCheckSAMLMetadata(s)),
+					testAccCheckSAMLTeamMappings(s, []client.SAMLTeamMapping{
+						{AttributeName: "groups", AttributeValue: "engineers", Org: "engineering"},
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase, and leaves the settings in place
+		},
+		CheckDestroy: func(*terraform.State) error {
+			if !s.SAMLSettings().Enabled {
+				return fmt.Errorf("SAML settings were removed from MKE")
+			}
+			return nil
+		},
+	})
+}
+
+func testSAMLConfigResource(spHost, mappings string) string {
+	return fmt.Sprintf(`
+	resource "mke_saml_config" "test" {
+		idp_metadata_url = "https://idp.example.com/metadata"
+		sp_host = "%s"
+		%s
+	}`, spHost, mappings)
+}
+
+// testAccCheckSAMLMetadata confirm that the service provider metadata matches the fake MKE server.
+func testAccCheckSAMLMetadata(s *mketest.Server) resource.CheckResourceAttrWithFunc {
+	return func(value string) error {
+		if expected := s.SAMLMetadata(); value != expected {
+			return fmt.Errorf("sp_metadata is %q, expected %q", value, expected)
+		}
+		return nil
+	}
+}
+
+// testAccCheckSAMLTeamMappings confirm the SAML team mappings in the fake MKE server.
+func testAccCheckSAMLTeamMappings(s *mketest.Server, expected []client.SAMLTeamMapping) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		mappings := s.SAMLSettings().TeamMappings
+		if len(mappings) != len(expected) {
+			return fmt.Errorf("SAML has team mappings %+v, expected %+v", mappings, expected)
+		}
+		for i := range expected {
+			if mappings[i] != expected[i] {
+				return fmt.Errorf("SAML has team mappings %+v, expected %+v", mappings, expected)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// SCIMConfigID the ID of the singleton SCIM config.
+	SCIMConfigID = "scim"
+)
+
+var _ resource.Resource = &SCIMConfigResource{}
+var _ resource.ResourceWithImportState = &SCIMConfigResource{}
+
+type SCIMConfigResourceModel struct {
+	Id      types.String `tfsdk:"id"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+}
+
+// FromSCIMSettings populate the model from client SCIM settings.
+func (m *SCIMConfigResourceModel) FromSCIMSettings(settings client.SCIMSettings) {
+	m.Id = types.StringValue(SCIMConfigID)
+	m.Enabled = types.BoolValue(settings.Enabled)
+}
+
+type SCIMConfigResource struct {
+	providerModel MKEProviderModel
+}
+
+func NewSCIMConfigResource() resource.Resource {
+	return &SCIMConfigResource{}
+}
+
+func (r *SCIMConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scim_config"
+}
+
+func (r *SCIMConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "SCIM user provisioning settings of the cluster. " +
+			"There is only one SCIM config per cluster, so only declare this resource once. " +
+			"Destroying the resource disables SCIM. Use `mke_scim_token` for the tokens the IdP provisions with.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier, always `scim`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Accept SCIM user provisioning from an IdP",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *SCIMConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	lpm, ok := req.ProviderData.(MKEProviderModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *MKEProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	tflog.Debug(ctx, "Successfully interpeted provider model", map[string]interface{}{})
+	r.providerModel = lpm
+}
+
+func (r *SCIMConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_scim_config", "create")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data SCIMConfigResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	rSettings, err := cl.ApiSCIMSettingsUpdate(ctx, client.SCIMSettings{Enabled: data.Enabled.ValueBool()})
+	if err != nil {
+		resp.Diagnostics.AddError("Create SCIM config error", err.Error())
+		return
+	}
+
+	data.FromSCIMSettings(rSettings)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SCIMConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_scim_config", "read")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data SCIMConfigResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	rSettings, err := cl.ApiSCIMSettingsRead(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Read SCIM config error", err.Error())
+		return
+	}
+
+	data.FromSCIMSettings(rSettings)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SCIMConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_scim_config", "update")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data SCIMConfigResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	rSettings, err := cl.ApiSCIMSettingsUpdate(ctx, client.SCIMSettings{Enabled: data.Enabled.ValueBool()})
+	if err != nil {
+		resp.Diagnostics.AddError("Update SCIM config error", err.Error())
+		return
+	}
+
+	data.FromSCIMSettings(rSettings)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, "Updated 'scim_config' resource", map[string]any{"success": true})
+}
+
+func (r *SCIMConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_scim_config", "delete")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	// users provisioned through SCIM keep their accounts, so disabling it is safe
+	if _, err := cl.ApiSCIMSettingsUpdate(ctx, client.SCIMSettings{Enabled: false}); err != nil {
+		resp.Diagnostics.AddError("Delete SCIM config error", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Disabled SCIM", map[string]any{"success": true})
+}
+
+func (r *SCIMConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
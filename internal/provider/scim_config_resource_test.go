@@ -0,0 +1,72 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/mketest"
+)
+
+func TestSCIMConfigResourceDefault(t *testing.T) {
+	s := testAccFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(s) + testSCIMConfigResource(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_scim_config.test", "id", "scim"),
+					resource.TestCheckResourceAttr("mke_scim_config.test", "enabled", "true"),
+					testAccCheckSCIMEnabled(s, true),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "mke_scim_config.test",
+				ImportState:       true,
+				ImportStateId:     "scim",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(s) + testSCIMConfigResource("enabled = false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_scim_config.test", "enabled", "false"),
+					testAccCheckSCIMEnabled(s, false),
+				),
+			},
+			// Re-enable, so that destroying is seen to disable it
+			{
+				Config: testAccProviderConfig(s) + testSCIMConfigResource(""),
+				Check:  testAccCheckSCIMEnabled(s, true),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+		CheckDestroy: func(*terraform.State) error {
+			return testAccCheckSCIMEnabled(s, false)(nil)
+		},
+	})
+}
+
+func testSCIMConfigResource(settings string) string {
+	return fmt.Sprintf(`
+	resource "mke_scim_config" "test" {
+		%s
+	}`, settings)
+}
+
+// testAccCheckSCIMEnabled confirm whether SCIM is enabled in the fake MKE server.
+func testAccCheckSCIMEnabled(s *mketest.Server, expected bool) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if enabled := s.SCIMSettings().Enabled; enabled != expected {
+			return fmt.Errorf("SCIM enabled is %t, expected %t", enabled, expected)
+		}
+		return nil
+	}
+}
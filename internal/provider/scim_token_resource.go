@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &SCIMTokenResource{}
+var _ resource.ResourceWithImportState = &SCIMTokenResource{}
+
+type SCIMTokenResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Description types.String `tfsdk:"description"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	Token       types.String `tfsdk:"token"`
+}
+
+// FromResponseSCIMToken populate the model from an eNZi SCIM token.
+// The token value is only kept if it was returned, as it is only returned when the token is created.
+func (m *SCIMTokenResourceModel) FromResponseSCIMToken(t client.ResponseSCIMToken) {
+	m.Id = types.StringValue(t.ID)
+	m.Description = types.StringValue(t.Description)
+	m.CreatedAt = types.StringValue(t.CreatedAt)
+	if t.Token != "" {
+		m.Token = types.StringValue(t.Token)
+	}
+}
+
+type SCIMTokenResource struct {
+	providerModel MKEProviderModel
+}
+
+func NewSCIMTokenResource() resource.Resource {
+	return &SCIMTokenResource{}
+}
+
+func (r *SCIMTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scim_token"
+}
+
+func (r *SCIMTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "SCIM bearer token, which an IdP uses to provision users in MKE. " +
+			"Destroying the resource revokes the token. " +
+			"MKE only returns the token value when it is generated, so imported tokens have no `token` value.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "What the token is used for. Changing the description generates a new token.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "When the token was generated",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The bearer token to give to the IdP",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SCIMTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	lpm, ok := req.ProviderData.(MKEProviderModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *MKEProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	tflog.Debug(ctx, "Successfully interpeted provider model", map[string]interface{}{})
+	r.providerModel = lpm
+}
+
+func (r *SCIMTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_scim_token", "create")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data SCIMTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	rToken, err := cl.ApiSCIMTokenCreate(ctx, client.CreateSCIMToken{Description: data.Description.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Create SCIM token error", err.Error())
+		return
+	}
+
+	data.FromResponseSCIMToken(rToken)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SCIMTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_scim_token", "read")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data SCIMTokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	rToken, err := cl.ApiSCIMTokenRead(ctx, data.Id.ValueString())
+	if client.IsNotFound(err) {
+		// the token was revoked outside of terraform, so it should be removed from state
+		resp.Diagnostics.AddWarning("SCIM token in state not found in MKE API", err.Error())
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Read SCIM token error", err.Error())
+		return
+	}
+
+	data.FromResponseSCIMToken(rToken)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SCIMTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	_, span := startOperationSpan(ctx, "mke_scim_token", "update")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	// SCIM tokens cannot be updated, but every configurable attribute requires replacement, so an update shouldn't be needed.
+}
+
+func (r *SCIMTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_scim_token", "delete")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data SCIMTokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	if err := cl.ApiSCIMTokenDelete(ctx, data.Id.ValueString()); client.IsNotFound(err) {
+		tflog.Debug(ctx, "SCIM token was already revoked in MKE", map[string]any{"id": data.Id.ValueString()})
+	} else if err != nil {
+		resp.Diagnostics.AddError("Delete SCIM token error", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Revoked SCIM token resource", map[string]any{"success": true})
+}
+
+func (r *SCIMTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,97 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/mketest"
+)
+
+func TestSCIMTokenResourceDefault(t *testing.T) {
+	s := testAccFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(s) + testSCIMTokenResource("okta"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("mke_scim_token.test", "id"),
+					resource.TestCheckResourceAttrSet("mke_scim_token.test", "created_at"),
+					resource.TestCheckResourceAttrWith("mke_scim_token.test", "token", testAccCheckSCIMTokenIssued(s)),
+				),
+			},
+			// ImportState testing, the token value is only known when it is created
+			{
+				ResourceName:            "mke_scim_token.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+			// Changing the description generates a new token, and revokes the old one
+			{
+				Config: testAccProviderConfig(s) + testSCIMTokenResource("okta prod"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_scim_token.test", "description", "okta prod"),
+					resource.TestCheckResourceAttrWith("mke_scim_token.test", "token", testAccCheckSCIMTokenIssued(s)),
+					testAccCheckSCIMTokenCount(s, 1),
+				),
+			},
+			// A token revoked outside of terraform is generated again
+			{
+				PreConfig: func() {
+					for _, t := range s.SCIMTokens() {
+						s.RevokeSCIMToken(t.ID)
+					}
+				},
+				Config: testAccProviderConfig(s) + testSCIMTokenResource("okta prod"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSCIMTokenCount(s, 1),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+		CheckDestroy: func(*terraform.State) error {
+			return testAccCheckSCIMTokenCount(s, 0)(nil)
+		},
+	})
+}
+
+func testSCIMTokenResource(description string) string {
+	return fmt.Sprintf(`
+	resource "mke_scim_config" "test" {
+	}
+
+	resource "mke_scim_token" "test" {
+		description = "%s"
+
+		depends_on = [mke_scim_config.test]
+	}`, description)
+}
+
+// testAccCheckSCIMTokenIssued confirm that a token value was issued by the fake MKE server.
+func testAccCheckSCIMTokenIssued(s *mketest.Server) resource.CheckResourceAttrWithFunc {
+	return func(value string) error {
+		for _, t := range s.SCIMTokens() {
+			if t.Token == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("SCIM token %q was not issued by MKE", value)
+	}
+}
+
+// testAccCheckSCIMTokenCount confirm how many SCIM tokens have not been revoked in the fake MKE server.
+func testAccCheckSCIMTokenCount(s *mketest.Server, expected int) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if tokens := s.SCIMTokens(); len(tokens) != expected {
+			return fmt.Errorf("MKE has %d SCIM tokens, expected %d", len(tokens), expected)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,327 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &TeamLDAPSyncResource{}
+var _ resource.ResourceWithImportState = &TeamLDAPSyncResource{}
+var _ resource.ResourceWithValidateConfig = &TeamLDAPSyncResource{}
+
+type TeamLDAPSyncResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	Org                types.String `tfsdk:"org"`
+	Team               types.String `tfsdk:"team"`
+	EnableSync         types.Bool   `tfsdk:"enable_sync"`
+	SelectGroupMembers types.Bool   `tfsdk:"select_group_members"`
+	GroupDN            types.String `tfsdk:"group_dn"`
+	GroupMemberAttr    types.String `tfsdk:"group_member_attr"`
+	SearchBaseDN       types.String `tfsdk:"search_base_dn"`
+	SearchScopeSubtree types.Bool   `tfsdk:"search_scope_subtree"`
+	SearchFilter       types.String `tfsdk:"search_filter"`
+}
+
+// MemberSyncConfig convert the model to the client sync config.
+func (m TeamLDAPSyncResourceModel) MemberSyncConfig() client.MemberSyncConfig {
+	return client.MemberSyncConfig{
+		EnableSync:         m.EnableSync.ValueBool(),
+		SelectGroupMembers: m.SelectGroupMembers.ValueBool(),
+		GroupDN:            m.GroupDN.ValueString(),
+		GroupMemberAttr:    m.GroupMemberAttr.ValueString(),
+		SearchBaseDN:       m.SearchBaseDN.ValueString(),
+		SearchScopeSubtree: m.SearchScopeSubtree.ValueBool(),
+		SearchFilter:       m.SearchFilter.ValueString(),
+	}
+}
+
+// FromMemberSyncConfig populate the model from the client sync config.
+func (m *TeamLDAPSyncResourceModel) FromMemberSyncConfig(msc client.MemberSyncConfig) {
+	m.Id = types.StringValue(fmt.Sprintf("%s/%s", m.Org.ValueString(), m.Team.ValueString()))
+	m.EnableSync = types.BoolValue(msc.EnableSync)
+	m.SelectGroupMembers = types.BoolValue(msc.SelectGroupMembers)
+	m.GroupDN = types.StringValue(msc.GroupDN)
+	m.GroupMemberAttr = types.StringValue(msc.GroupMemberAttr)
+	m.SearchBaseDN = types.StringValue(msc.SearchBaseDN)
+	m.SearchScopeSubtree = types.BoolValue(msc.SearchScopeSubtree)
+	m.SearchFilter = types.StringValue(msc.SearchFilter)
+}
+
+// hasUnknowns are any of the sync settings not yet known.
+func (m TeamLDAPSyncResourceModel) hasUnknowns() bool {
+	return m.EnableSync.IsUnknown() || m.SelectGroupMembers.IsUnknown() ||
+		m.GroupDN.IsUnknown() || m.GroupMemberAttr.IsUnknown() ||
+		m.SearchBaseDN.IsUnknown() || m.SearchFilter.IsUnknown()
+}
+
+type TeamLDAPSyncResource struct {
+	providerModel MKEProviderModel
+}
+
+func NewTeamLDAPSyncResource() resource.Resource {
+	return &TeamLDAPSyncResource{}
+}
+
+func (r *TeamLDAPSyncResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_ldap_sync"
+}
+
+func (r *TeamLDAPSyncResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "LDAP group sync of the members of a team. " +
+			"Members are either taken from the member attribute of an LDAP group (`select_group_members = true`, " +
+			"which needs `group_dn` and `group_member_attr`), or found with an LDAP search (which needs " +
+			"`search_base_dn` and `search_filter`). Destroying the resource disables the sync.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier, as `org/team`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org": schema.StringAttribute{
+				MarkdownDescription: "The name of the organization that the team belongs to",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team": schema.StringAttribute{
+				MarkdownDescription: "The name of the team",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enable_sync": schema.BoolAttribute{
+				MarkdownDescription: "Sync the team members from LDAP",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"select_group_members": schema.BoolAttribute{
+				MarkdownDescription: "Take the members from the member attribute of an LDAP group, instead of an LDAP search",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"group_dn": schema.StringAttribute{
+				MarkdownDescription: "The distinguished name of the LDAP group",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"group_member_attr": schema.StringAttribute{
+				MarkdownDescription: "The LDAP group attribute which lists its members, e.g. `member`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"search_base_dn": schema.StringAttribute{
+				MarkdownDescription: "The distinguished name of the node to search for members from",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"search_scope_subtree": schema.BoolAttribute{
+				MarkdownDescription: "Search the whole subtree of the base DN, instead of only one level",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"search_filter": schema.StringAttribute{
+				MarkdownDescription: "The LDAP search filter used to find members",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+// ValidateConfig check that the attributes needed for the selected sync mode are set.
+func (r *TeamLDAPSyncResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data TeamLDAPSyncResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.hasUnknowns() {
+		return
+	}
+
+	msc := data.MemberSyncConfig()
+	if data.EnableSync.IsNull() {
+		msc.EnableSync = true
+	}
+
+	if err := msc.Validate(); err != nil {
+		resp.Diagnostics.AddError("Invalid team LDAP sync configuration", err.Error())
+	}
+}
+
+func (r *TeamLDAPSyncResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	lpm, ok := req.ProviderData.(MKEProviderModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *MKEProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	tflog.Debug(ctx, "Successfully interpeted provider model", map[string]interface{}{})
+	r.providerModel = lpm
+}
+
+func (r *TeamLDAPSyncResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_team_ldap_sync", "create")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data TeamLDAPSyncResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	msc, err := cl.ApiTeamMemberSyncConfigUpdate(ctx, data.Org.ValueString(), data.Team.ValueString(), data.MemberSyncConfig())
+	if err != nil {
+		resp.Diagnostics.AddError("Create team LDAP sync error", err.Error())
+		return
+	}
+
+	data.FromMemberSyncConfig(msc)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamLDAPSyncResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_team_ldap_sync", "read")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data TeamLDAPSyncResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	msc, err := cl.ApiTeamMemberSyncConfigRead(ctx, data.Org.ValueString(), data.Team.ValueString())
+	if client.IsNotFound(err) {
+		// the team was removed outside of terraform, so its sync config should be removed from state
+		resp.Diagnostics.AddWarning("Team in state not found in MKE API", err.Error())
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Read team LDAP sync error", err.Error())
+		return
+	}
+
+	data.FromMemberSyncConfig(msc)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamLDAPSyncResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_team_ldap_sync", "update")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data TeamLDAPSyncResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	msc, err := cl.ApiTeamMemberSyncConfigUpdate(ctx, data.Org.ValueString(), data.Team.ValueString(), data.MemberSyncConfig())
+	if err != nil {
+		resp.Diagnostics.AddError("Update team LDAP sync error", err.Error())
+		return
+	}
+
+	data.FromMemberSyncConfig(msc)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, "Updated 'team_ldap_sync' resource", map[string]any{"success": true})
+}
+
+func (r *TeamLDAPSyncResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_team_ldap_sync", "delete")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data TeamLDAPSyncResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	// there is nothing to delete, so the sync is disabled and the settings cleared
+	if _, err := cl.ApiTeamMemberSyncConfigUpdate(ctx, data.Org.ValueString(), data.Team.ValueString(), client.MemberSyncConfig{}); client.IsNotFound(err) {
+		tflog.Debug(ctx, "Team was already removed from MKE", map[string]any{"id": data.Id.ValueString()})
+	} else if err != nil {
+		resp.Diagnostics.AddError("Delete team LDAP sync error", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted team_ldap_sync resource", map[string]any{"success": true})
+}
+
+// ImportState team LDAP sync is imported with an `org/team` ID.
+func (r *TeamLDAPSyncResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, ok := splitImportID(req.ID, 2)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected import identifier", fmt.Sprintf("Expected an import identifier like `org/team`, got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team"), parts[1])...)
+}
@@ -0,0 +1,112 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/Mirantis/terraform-provider-mke/internal/mketest"
+)
+
+func TestTeamLDAPSyncResourceDefault(t *testing.T) {
+	s := testAccFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid mode combinations are rejected before apply
+			{
+				Config: testAccProviderConfig(s) + testTeamLDAPSyncResource(`
+					select_group_members = true
+					group_dn = "cn=devs,dc=example,dc=com"
+				`),
+				ExpectError: regexp.MustCompile("Invalid team LDAP sync configuration"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(s) + testTeamLDAPSyncResource(`
+					select_group_members = true
+					group_dn = "cn=devs,dc=example,dc=com"
+					group_member_attr = "member"
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_team_ldap_sync.test", "id", "testorg/testteam"),
+					resource.TestCheckResourceAttr("mke_team_ldap_sync.test", "enable_sync", "true"),
+					testAccCheckTeamMemberSyncConfig(s, "testorg", "testteam", client.MemberSyncConfig{
+						EnableSync:         true,
+						SelectGroupMembers: true,
+						GroupDN:            "cn=devs,dc=example,dc=com",
+						GroupMemberAttr:    "member",
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "mke_team_ldap_sync.test",
+				ImportState:       true,
+				ImportStateId:     "testorg/testteam",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing, switching to search mode
+			{
+				Config: testAccProviderConfig(s) + testTeamLDAPSyncResource(`
+					search_base_dn = "ou=people,dc=example,dc=com"
+					search_filter = "(department=dev)"
+					search_scope_subtree = true
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_team_ldap_sync.test", "select_group_members", "false"),
+					resource.TestCheckResourceAttr("mke_team_ldap_sync.test", "group_dn", ""),
+					testAccCheckTeamMemberSyncConfig(s, "testorg", "testteam", client.MemberSyncConfig{
+						EnableSync:         true,
+						SearchBaseDN:       "ou=people,dc=example,dc=com",
+						SearchFilter:       "(department=dev)",
+						SearchScopeSubtree: true,
+					}),
+				),
+			},
+			// Destroying the sync, but not the team, disables it
+			{
+				Config: testAccProviderConfig(s) + testTeamResource(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTeamMemberSyncConfig(s, "testorg", "testteam", client.MemberSyncConfig{}),
+				),
+			},
+		},
+	})
+}
+
+func testTeamLDAPSyncResource(settings string) string {
+	return fmt.Sprintf(`
+	resource "mke_org" "test" {
+		name = "testorg"
+	}
+
+	resource "mke_team" "test" {
+		org = mke_org.test.name
+		name = "testteam"
+	}
+
+	resource "mke_team_ldap_sync" "test" {
+		org = mke_team.test.org
+		team = mke_team.test.name
+		%s
+	}`, settings)
+}
+
+// testAccCheckTeamMemberSyncConfig confirm the LDAP sync config of a team in the fake MKE server.
+// A team which no longer exists is treated as having no sync config.
+func testAccCheckTeamMemberSyncConfig(s *mketest.Server, org, team string, expected client.MemberSyncConfig) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		msc, _ := s.TeamMemberSyncConfig(org, team)
+		if msc != expected {
+			return fmt.Errorf("team %s/%s has sync config %+v, expected %+v", org, team, msc, expected)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,74 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+func TestTeamMemberResourceDefault(t *testing.T) {
+	s := testAccFakeServer(t)
+	for _, name := range []string{"user1", "user2"} {
+		s.CreateAccount(client.CreateAccount{Name: name, Password: "password", IsActive: true})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(s) + testTeamMemberResource(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_team_member.test", "id", "testorg/testteam/user1"),
+					resource.TestCheckResourceAttr("mke_team_member.test", "is_admin", "false"),
+					testAccCheckTeamMembersInMKE(s, "testorg", "testteam", map[string]bool{"user1": false}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "mke_team_member.test",
+				ImportState:       true,
+				ImportStateId:     "testorg/testteam/user1",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing, with a member added outside of terraform
+			{
+				PreConfig: func() { s.SetTeamMember("testorg", "testteam", "user2", true) },
+				Config:    testAccProviderConfig(s) + testTeamMemberResource(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_team_member.test", "is_admin", "true"),
+					testAccCheckTeamMembersInMKE(s, "testorg", "testteam", map[string]bool{"user1": true, "user2": true}),
+				),
+			},
+			// Removed outside of terraform, so it is added again
+			{
+				PreConfig: func() { s.RemoveTeamMember("testorg", "testteam", "user1") },
+				Config:    testAccProviderConfig(s) + testTeamMemberResource(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTeamMembersInMKE(s, "testorg", "testteam", map[string]bool{"user1": true, "user2": true}),
+				),
+			},
+			// Removing the membership leaves the other member alone
+			{
+				Config: testAccProviderConfig(s) + testTeamResource(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTeamMembersInMKE(s, "testorg", "testteam", map[string]bool{"user2": true}),
+				),
+			},
+		},
+	})
+}
+
+func testTeamMemberResource(isAdmin bool) string {
+	return testTeamResource("") + fmt.Sprintf(`
+	resource "mke_team_member" "test" {
+		org = mke_team.test.org
+		team = mke_team.test.name
+		user = "user1"
+		is_admin = %t
+	}`, isAdmin)
+}
@@ -0,0 +1,311 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &TeamMembersResource{}
+var _ resource.ResourceWithImportState = &TeamMembersResource{}
+var _ resource.ResourceWithValidateConfig = &TeamMembersResource{}
+
+type TeamMembersResourceModel struct {
+	Id      types.String      `tfsdk:"id"`
+	Org     types.String      `tfsdk:"org"`
+	Team    types.String      `tfsdk:"team"`
+	Members []TeamMemberModel `tfsdk:"members"`
+}
+
+type TeamMemberModel struct {
+	User    types.String `tfsdk:"user"`
+	IsAdmin types.Bool   `tfsdk:"is_admin"`
+}
+
+// DesiredMembers the member names and admin flags from the model.
+func (m TeamMembersResourceModel) DesiredMembers() map[string]bool {
+	desired := map[string]bool{}
+	for _, tm := range m.Members {
+		desired[tm.User.ValueString()] = tm.IsAdmin.ValueBool()
+	}
+	return desired
+}
+
+// FromResponseMembers populate the model members from the eNZi team members.
+func (m *TeamMembersResourceModel) FromResponseMembers(members []client.ResponseMember) {
+	m.Members = []TeamMemberModel{}
+	for _, rm := range members {
+		m.Members = append(m.Members, TeamMemberModel{
+			User:    types.StringValue(rm.Member.Name),
+			IsAdmin: types.BoolValue(rm.IsAdmin),
+		})
+	}
+}
+
+type TeamMembersResource struct {
+	providerModel MKEProviderModel
+}
+
+func NewTeamMembersResource() resource.Resource {
+	return &TeamMembersResource{}
+}
+
+func (r *TeamMembersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_members"
+}
+
+func (r *TeamMembersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritative team membership. The resource owns the full member list of the team, " +
+			"and removes any member which is not in the configuration. Do not combine it with `mke_team_member` for the same team.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier, as `org/team`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org": schema.StringAttribute{
+				MarkdownDescription: "The name of the organization that the team belongs to",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team": schema.StringAttribute{
+				MarkdownDescription: "The name of the team",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"members": schema.SetNestedAttribute{
+				MarkdownDescription: "The complete set of team members",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user": schema.StringAttribute{
+							MarkdownDescription: "The name of the user",
+							Required:            true,
+						},
+						"is_admin": schema.BoolAttribute{
+							MarkdownDescription: "Is the user a team admin",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig check that no user is listed more than once, as the members would never converge.
+func (r *TeamMembersResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var members types.Set
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("members"), &members)...)
+	if resp.Diagnostics.HasError() || members.IsNull() || members.IsUnknown() {
+		return
+	}
+
+	var data []TeamMemberModel
+	resp.Diagnostics.Append(members.ElementsAs(ctx, &data, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[string]bool{}
+	for _, tm := range data {
+		if tm.User.IsNull() || tm.User.IsUnknown() {
+			continue
+		}
+		user := tm.User.ValueString()
+		if seen[user] {
+			resp.Diagnostics.AddAttributeError(path.Root("members"), "Duplicate team member", fmt.Sprintf("User %s is listed more than once in members.", user))
+		}
+		seen[user] = true
+	}
+}
+
+func (r *TeamMembersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	lpm, ok := req.ProviderData.(MKEProviderModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *MKEProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	tflog.Debug(ctx, "Successfully interpeted provider model", map[string]interface{}{})
+	r.providerModel = lpm
+}
+
+func (r *TeamMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_team_members", "create")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data TeamMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	if err := r.reconcile(ctx, cl, data); err != nil {
+		resp.Diagnostics.AddError("Create team members error", err.Error())
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", data.Org.ValueString(), data.Team.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_team_members", "read")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data TeamMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	members, err := cl.ApiTeamMemberList(ctx, data.Org.ValueString(), data.Team.ValueString())
+	if client.IsNotFound(err) {
+		// the team was removed outside of terraform, so its membership should be removed from state
+		resp.Diagnostics.AddWarning("Team in state not found in MKE API", err.Error())
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Read team members error", err.Error())
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", data.Org.ValueString(), data.Team.ValueString()))
+	data.FromResponseMembers(members)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_team_members", "update")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data TeamMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	if err := r.reconcile(ctx, cl, data); err != nil {
+		resp.Diagnostics.AddError("Update team members error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, "Updated 'team_members' resource", map[string]any{"success": true})
+}
+
+func (r *TeamMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_team_members", "delete")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data TeamMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	// only the members known to terraform are removed
+	diff := client.MembersDiff{Remove: []string{}}
+	for name := range data.DesiredMembers() {
+		diff.Remove = append(diff.Remove, name)
+	}
+
+	if err := cl.ApiTeamMembersApply(ctx, data.Org.ValueString(), data.Team.ValueString(), diff); client.IsNotFound(err) {
+		tflog.Debug(ctx, "Team was already removed from MKE", map[string]any{"id": data.Id.ValueString()})
+	} else if err != nil {
+		resp.Diagnostics.AddError("Delete team members error", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted team_members resource", map[string]any{"success": true})
+}
+
+// ImportState team members are imported with an `org/team` ID.
+func (r *TeamMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, ok := splitImportID(req.ID, 2)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected import identifier", fmt.Sprintf("Expected an import identifier like `org/team`, got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team"), parts[1])...)
+}
+
+// reconcile change the team members in MKE to match the model, only sending the differences.
+func (r *TeamMembersResource) reconcile(ctx context.Context, cl client.Client, data TeamMembersResourceModel) error {
+	current, err := cl.ApiTeamMemberList(ctx, data.Org.ValueString(), data.Team.ValueString())
+	if err != nil {
+		return err
+	}
+
+	diff := client.DiffMembers(current, data.DesiredMembers())
+	tflog.Debug(ctx, "Team member changes", map[string]any{"set": diff.Set, "remove": diff.Remove})
+
+	return cl.ApiTeamMembersApply(ctx, data.Org.ValueString(), data.Team.ValueString(), diff)
+}
@@ -0,0 +1,118 @@
+package provider_test
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/Mirantis/terraform-provider-mke/internal/mketest"
+)
+
+func TestTeamMembersResourceDefault(t *testing.T) {
+	s := testAccFakeServer(t)
+	for _, name := range []string{"user1", "user2", "user3"} {
+		s.CreateAccount(client.CreateAccount{Name: name, Password: "password", IsActive: true})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(s) + testTeamMembersResource(`
+					{ user = "user1", is_admin = true },
+					{ user = "user2" },
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_team_members.test", "id", "testorg/testteam"),
+					resource.TestCheckResourceAttr("mke_team_members.test", "members.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("mke_team_members.test", "members.*", map[string]string{"user": "user2", "is_admin": "false"}),
+					testAccCheckTeamMembersInMKE(s, "testorg", "testteam", map[string]bool{"user1": true, "user2": false}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "mke_team_members.test",
+				ImportState:       true,
+				ImportStateId:     "testorg/testteam",
+				ImportStateVerify: true,
+			},
+			// A member added outside of terraform is removed again
+			{
+				PreConfig: func() { s.SetTeamMember("testorg", "testteam", "user3", false) },
+				Config: testAccProviderConfig(s) + testTeamMembersResource(`
+					{ user = "user1", is_admin = true },
+					{ user = "user2" },
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTeamMembersInMKE(s, "testorg", "testteam", map[string]bool{"user1": true, "user2": false}),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(s) + testTeamMembersResource(`
+					{ user = "user1" },
+					{ user = "user3", is_admin = true },
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_team_members.test", "members.#", "2"),
+					testAccCheckTeamMembersInMKE(s, "testorg", "testteam", map[string]bool{"user1": false, "user3": true}),
+				),
+			},
+			// Destroying the membership, but not the team, empties it
+			{
+				Config: testAccProviderConfig(s) + testTeamResource(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTeamMembersInMKE(s, "testorg", "testteam", map[string]bool{}),
+				),
+			},
+		},
+	})
+}
+
+func TestTeamMembersResourceDuplicateUser(t *testing.T) {
+	s := testAccFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(s) + testTeamMembersResource(`
+					{ user = "user1", is_admin = true },
+					{ user = "user1" },
+				`),
+				ExpectError: regexp.MustCompile(`User user1 is listed more than once in\s+members`),
+			},
+		},
+	})
+}
+
+func testTeamMembersResource(members string) string {
+	return testTeamResource("") + fmt.Sprintf(`
+	resource "mke_team_members" "test" {
+		org = mke_team.test.org
+		team = mke_team.test.name
+		members = [%s]
+	}`, members)
+}
+
+// testAccCheckTeamMembersInMKE confirm that the team in the fake MKE server has exactly the expected members.
+func testAccCheckTeamMembersInMKE(s *mketest.Server, org, team string, expected map[string]bool) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		members, ok := s.TeamMembers(org, team)
+		if !ok {
+			return fmt.Errorf("team %s/%s does not exist in MKE", org, team)
+		}
+		if !reflect.DeepEqual(members, expected) {
+			return fmt.Errorf("team %s/%s has members %v, expected %v", org, team, members, expected)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,103 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/mketest"
+)
+
+func TestTeamResourceDefault(t *testing.T) {
+	s := testAccFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckTeamDestroyed(s, "testorg", "testteam"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(s) + testTeamResource("first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_team.test", "org", "testorg"),
+					resource.TestCheckResourceAttr("mke_team.test", "name", "testteam"),
+					resource.TestCheckResourceAttr("mke_team.test", "description", "first"),
+					resource.TestCheckResourceAttrSet("mke_team.test", "id"),
+					testAccCheckTeamInMKE(s, "testorg", "testteam", "first"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "mke_team.test",
+				ImportState:       true,
+				ImportStateId:     "testorg/testteam",
+				ImportStateVerify: true,
+			},
+			// Malformed import IDs are rejected
+			{
+				ResourceName:  "mke_team.test",
+				ImportState:   true,
+				ImportStateId: "testteam",
+				ExpectError:   regexp.MustCompile("Unexpected import identifier"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(s) + testTeamResource("second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_team.test", "description", "second"),
+					testAccCheckTeamInMKE(s, "testorg", "testteam", "second"),
+				),
+			},
+			// Removed outside of terraform, so it is recreated
+			{
+				PreConfig: func() { s.RemoveTeam("testorg", "testteam") },
+				Config:    testAccProviderConfig(s) + testTeamResource("second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTeamInMKE(s, "testorg", "testteam", "second"),
+				),
+			},
+			// Delete is called implicitly
+		},
+	})
+}
+
+func testTeamResource(description string) string {
+	return fmt.Sprintf(`
+	resource "mke_org" "test" {
+		name = "testorg"
+	}
+
+	resource "mke_team" "test" {
+		org = mke_org.test.name
+		name = "testteam"
+		description = %q
+	}`, description)
+}
+
+// testAccCheckTeamInMKE confirm that the fake MKE server has the expected team.
+func testAccCheckTeamInMKE(s *mketest.Server, org, name, description string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		team, ok := s.Team(org, name)
+		if !ok {
+			return fmt.Errorf("team %s/%s does not exist in MKE", org, name)
+		}
+		if team.Description != description {
+			return fmt.Errorf("team %s/%s has description %q, expected %q", org, name, team.Description, description)
+		}
+		return nil
+	}
+}
+
+// testAccCheckTeamDestroyed confirm that the fake MKE server no longer has the team.
+func testAccCheckTeamDestroyed(s *mketest.Server, org, name string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if _, ok := s.Team(org, name); ok {
+			return fmt.Errorf("team %s/%s still exists in MKE", org, name)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &TeamsDataSource{}
+
+type TeamsDataSourceModel struct {
+	Org   types.String               `tfsdk:"org"`
+	Teams []TeamsDataSourceTeamModel `tfsdk:"teams"`
+}
+
+type TeamsDataSourceTeamModel struct {
+	Id           types.String `tfsdk:"id"`
+	OrgID        types.String `tfsdk:"org_id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	MembersCount types.Int64  `tfsdk:"members_count"`
+}
+
+// FromResponseTeam populate the model from an MKE team.
+func (m *TeamsDataSourceTeamModel) FromResponseTeam(team client.ResponseTeam) {
+	m.Id = types.StringValue(team.ID)
+	m.OrgID = types.StringValue(team.OrgID)
+	m.Name = types.StringValue(team.Name)
+	m.Description = types.StringValue(team.Description)
+	m.MembersCount = types.Int64Value(int64(team.MembersCount))
+}
+
+type TeamsDataSource struct {
+	providerModel MKEProviderModel
+}
+
+func NewTeamsDataSource() datasource.DataSource {
+	return &TeamsDataSource{}
+}
+
+func (d *TeamsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_teams"
+}
+
+func (d *TeamsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "All of the teams of an MKE organization, sorted by name.",
+
+		Attributes: map[string]schema.Attribute{
+			"org": schema.StringAttribute{
+				MarkdownDescription: "The name or ID of the organization",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"teams": schema.ListNestedAttribute{
+				MarkdownDescription: "The teams",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the team",
+							Computed:            true,
+						},
+						"org_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the organization",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the team",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the team",
+							Computed:            true,
+						},
+						"members_count": schema.Int64Attribute{
+							MarkdownDescription: "How many members the team has",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TeamsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	lpm, ok := req.ProviderData.(MKEProviderModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *MKEProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	tflog.Debug(ctx, "Successfully interpeted provider model", map[string]interface{}{})
+	d.providerModel = lpm
+}
+
+func (d *TeamsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_teams", "read")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data TeamsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := d.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	teams, err := cl.ApiTeamList(ctx, data.Org.ValueString())
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Organization not found", fmt.Sprintf("No MKE organization %s was found", data.Org.ValueString()))
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("List teams error", err.Error())
+		return
+	}
+
+	data.Teams = []TeamsDataSourceTeamModel{}
+	for _, t := range teams {
+		var team TeamsDataSourceTeamModel
+		team.FromResponseTeam(t)
+		data.Teams = append(data.Teams, team)
+	}
+	sort.Slice(data.Teams, func(i, j int) bool { return data.Teams[i].Name.ValueString() < data.Teams[j].Name.ValueString() })
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,51 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+func TestTeamsDataSourceDefault(t *testing.T) {
+	s := testAccFakeServer(t)
+	org := s.CreateAccount(client.CreateAccount{Name: "testorg", IsOrg: true})
+	// more teams than fit on a page of the fake server listing
+	for i := 0; i < 105; i++ {
+		s.CreateTeam("testorg", client.CreateTeam{Name: fmt.Sprintf("team%03d", i), Description: "A team"})
+	}
+	s.CreateAccount(client.CreateAccount{Name: "user1", Password: "password", IsActive: true})
+	s.SetTeamMember("testorg", "team000", "user1", false)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(s) + testTeamsDataSource("testorg"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.mke_teams.test", "teams.#", "105"),
+					resource.TestCheckResourceAttr("data.mke_teams.test", "teams.0.name", "team000"),
+					resource.TestCheckResourceAttr("data.mke_teams.test", "teams.0.org_id", org.ID),
+					resource.TestCheckResourceAttr("data.mke_teams.test", "teams.0.description", "A team"),
+					resource.TestCheckResourceAttr("data.mke_teams.test", "teams.0.members_count", "1"),
+					resource.TestCheckResourceAttr("data.mke_teams.test", "teams.104.name", "team104"),
+				),
+			},
+			{
+				Config:      testAccProviderConfig(s) + testTeamsDataSource("noorg"),
+				ExpectError: regexp.MustCompile(`No\s+MKE\s+organization\s+noorg`),
+			},
+		},
+	})
+}
+
+func testTeamsDataSource(org string) string {
+	return fmt.Sprintf(`
+	data "mke_teams" "test" {
+		org = %q
+	}`, org)
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// TracerName instrumentation name used for provider operation spans.
+	TracerName = "github.com/Mirantis/terraform-provider-mke/internal/provider"
+
+	// ServiceName OpenTelemetry service name reported for the provider process.
+	ServiceName = "terraform-provider-mke"
+
+	// EnvOTLPEndpoint if either of these ENV variables is set, spans are exported over OTLP.
+	EnvOTLPEndpoint       = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	EnvOTLPTracesEndpoint = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+
+	TraceAttributeResource  = "mke.resource"
+	TraceAttributeOperation = "mke.operation"
+)
+
+// ConfigureTracing install an OTLP exporting TracerProvider if an OTLP endpoint is configured.
+// The exporter reads the rest of its configuration from the standard OTEL_EXPORTER_OTLP_* ENV variables.
+// The returned function flushes and stops tracing, and is safe to call when tracing is disabled.
+func ConfigureTracing(ctx context.Context, version string) (func(context.Context) error, error) {
+	noop := func(context.Context) error { return nil }
+
+	if os.Getenv(EnvOTLPEndpoint) == "" && os.Getenv(EnvOTLPTracesEndpoint) == "" {
+		return noop, nil
+	}
+
+	exp, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return noop, err
+	}
+
+	tp := InstallTracerProvider(version, sdktrace.WithBatcher(exp))
+
+	return tp.Shutdown, nil
+}
+
+// InstallTracerProvider set the global TracerProvider and W3C propagator for the provider process.
+// Tests can pass sdktrace.WithSyncer(tracetest.NewInMemoryExporter()) to capture spans.
+func InstallTracerProvider(version string, opts ...sdktrace.TracerProviderOption) *sdktrace.TracerProvider {
+	res := sdkresource.NewSchemaless(
+		semconv.ServiceName(ServiceName),
+		semconv.ServiceVersion(version),
+	)
+
+	tp := sdktrace.NewTracerProvider(append([]sdktrace.TracerProviderOption{sdktrace.WithResource(res)}, opts...)...)
+
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return tp
+}
+
+// startOperationSpan start a span for a resource or data source CRUD operation.
+// All client requests made with the returned context become children of the span.
+func startOperationSpan(ctx context.Context, typeName, operation string) (context.Context, trace.Span) {
+	return otel.Tracer(TracerName).Start(
+		ctx,
+		typeName+"."+operation,
+		trace.WithAttributes(
+			attribute.String(TraceAttributeResource, typeName),
+			attribute.String(TraceAttributeOperation, operation),
+		),
+	)
+}
+
+// endOperationSpan mark the operation span as failed if any error diagnostics were produced, and end it.
+func endOperationSpan(span trace.Span, diags diag.Diagnostics) {
+	if diags.HasError() {
+		for _, d := range diags.Errors() {
+			span.AddEvent(d.Summary(), trace.WithAttributes(attribute.String("detail", d.Detail())))
+		}
+		span.SetStatus(codes.Error, diags.Errors()[0].Summary())
+	}
+
+	span.End()
+}
@@ -0,0 +1,70 @@
+package provider_test
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/provider"
+)
+
+func TestConfigureTracingDisabledWithoutEndpoint(t *testing.T) {
+	t.Setenv(provider.EnvOTLPEndpoint, "")
+	t.Setenv(provider.EnvOTLPTracesEndpoint, "")
+
+	prevTP := otel.GetTracerProvider()
+
+	shutdown, err := provider.ConfigureTracing(context.Background(), "test")
+	if err != nil {
+		t.Fatalf("tracing configuration failed: %s", err)
+	}
+	if err := shutdown(context.Background()); err != nil {
+		t.Errorf("no-op tracing shutdown failed: %s", err)
+	}
+	if otel.GetTracerProvider() != prevTP {
+		t.Error("a tracer provider was installed without an OTLP endpoint")
+	}
+}
+
+func TestConfigureTracingWithEndpoint(t *testing.T) {
+	t.Setenv(provider.EnvOTLPEndpoint, "http://localhost:4318")
+
+	prevTP := otel.GetTracerProvider()
+	defer otel.SetTracerProvider(prevTP)
+
+	shutdown, err := provider.ConfigureTracing(context.Background(), "test")
+	if err != nil {
+		t.Fatalf("tracing configuration failed: %s", err)
+	}
+
+	if _, ok := otel.GetTracerProvider().(*sdktrace.TracerProvider); !ok {
+		t.Errorf("expected an sdk tracer provider to be installed, got %T", otel.GetTracerProvider())
+	}
+
+	// no spans were created, so nothing is exported on shutdown
+	if err := shutdown(context.Background()); err != nil {
+		t.Errorf("tracing shutdown failed: %s", err)
+	}
+}
+
+func TestInstallTracerProviderInMemory(t *testing.T) {
+	prevTP := otel.GetTracerProvider()
+	defer otel.SetTracerProvider(prevTP)
+
+	exp := tracetest.NewInMemoryExporter()
+	provider.InstallTracerProvider("test", sdktrace.WithSyncer(exp))
+
+	_, span := otel.Tracer(provider.TracerName).Start(context.Background(), "mke_user.read")
+	span.End()
+
+	spans := exp.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("expected one exported span, got %d", len(spans))
+	}
+	if spans[0].Resource.String() == "" {
+		t.Error("exported span has no service resource")
+	}
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &UserDataSource{}
+
+type UserDataSourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	FullName     types.String `tfsdk:"full_name"`
+	IsActive     types.Bool   `tfsdk:"is_active"`
+	IsAdmin      types.Bool   `tfsdk:"is_admin"`
+	IsImported   types.Bool   `tfsdk:"is_imported"`
+	OnDemand     types.Bool   `tfsdk:"on_demand"`
+	OtpEnabled   types.Bool   `tfsdk:"otp_enabled"`
+	MembersCount types.Int64  `tfsdk:"members_count"`
+	TeamsCount   types.Int64  `tfsdk:"teams_count"`
+}
+
+// FromResponseAccount populate the model from an MKE account.
+func (m *UserDataSourceModel) FromResponseAccount(acc client.ResponseAccount) {
+	m.Id = types.StringValue(acc.ID)
+	m.Name = types.StringValue(acc.Name)
+	m.FullName = types.StringValue(acc.FullName)
+	m.IsActive = types.BoolValue(acc.IsActive)
+	m.IsAdmin = types.BoolValue(acc.IsAdmin)
+	m.IsImported = types.BoolValue(acc.IsImported)
+	m.OnDemand = types.BoolValue(acc.OnDemand)
+	m.OtpEnabled = types.BoolValue(acc.OtpEnabled)
+	m.MembersCount = types.Int64Value(int64(acc.MembersCount))
+	m.TeamsCount = types.Int64Value(int64(acc.TeamsCount))
+}
+
+// userDataSourceAttributes the computed account attributes of a user, other than its id and name.
+func userDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"full_name": schema.StringAttribute{
+			MarkdownDescription: "The full name of the user",
+			Computed:            true,
+		},
+		"is_active": schema.BoolAttribute{
+			MarkdownDescription: "Is the user active",
+			Computed:            true,
+		},
+		"is_admin": schema.BoolAttribute{
+			MarkdownDescription: "Is the user an admin",
+			Computed:            true,
+		},
+		"is_imported": schema.BoolAttribute{
+			MarkdownDescription: "Was the user imported from LDAP",
+			Computed:            true,
+		},
+		"on_demand": schema.BoolAttribute{
+			MarkdownDescription: "Was the user created on demand, e.g. on its first SAML login",
+			Computed:            true,
+		},
+		"otp_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Does the user have two-factor authentication enabled",
+			Computed:            true,
+		},
+		"members_count": schema.Int64Attribute{
+			MarkdownDescription: "How many members the account has, which is always 0 for users",
+			Computed:            true,
+		},
+		"teams_count": schema.Int64Attribute{
+			MarkdownDescription: "How many teams the account has, which is always 0 for users",
+			Computed:            true,
+		},
+	}
+}
+
+type UserDataSource struct {
+	providerModel MKEProviderModel
+}
+
+func NewUserDataSource() datasource.DataSource {
+	return &UserDataSource{}
+}
+
+func (d *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *UserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := userDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The ID of the user. Either the id or the name is required",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+			stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the user. Either the id or the name is required",
+		Optional:            true,
+		Computed:            true,
+		Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "An existing MKE user, looked up by name or ID.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *UserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	lpm, ok := req.ProviderData.(MKEProviderModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *MKEProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	tflog.Debug(ctx, "Successfully interpeted provider model", map[string]interface{}{})
+	d.providerModel = lpm
+}
+
+func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_user", "read")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data UserDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := d.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	// MKE accepts either the name or the ID of an account
+	nameOrID := data.Name.ValueString()
+	if nameOrID == "" {
+		nameOrID = data.Id.ValueString()
+	}
+
+	acc, err := cl.ApiReadAccount(ctx, nameOrID)
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddError("User not found", fmt.Sprintf("No MKE user %s was found", nameOrID))
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Read user error", err.Error())
+		return
+	}
+	if acc.IsOrg {
+		resp.Diagnostics.AddError("Not a user", fmt.Sprintf("MKE account %s is an organization", nameOrID))
+		return
+	}
+
+	data.FromResponseAccount(acc)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,81 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+func TestUserDataSourceDefault(t *testing.T) {
+	s := testAccFakeServer(t)
+	acc := s.CreateAccount(client.CreateAccount{Name: "auditor", FullName: "The Auditor", Password: "auditorpassword", IsActive: true})
+	s.ModifyAccount("auditor", func(acc *client.ResponseAccount) { acc.OtpEnabled = true })
+	s.CreateAccount(client.CreateAccount{Name: "someorg", IsOrg: true})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Look up by name
+			{
+				Config: testAccProviderConfig(s) + testUserDataSource("name", "auditor"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.mke_user.test", "id", acc.ID),
+					resource.TestCheckResourceAttr("data.mke_user.test", "full_name", "The Auditor"),
+					resource.TestCheckResourceAttr("data.mke_user.test", "is_active", "true"),
+					resource.TestCheckResourceAttr("data.mke_user.test", "is_admin", "false"),
+					resource.TestCheckResourceAttr("data.mke_user.test", "is_imported", "false"),
+					resource.TestCheckResourceAttr("data.mke_user.test", "otp_enabled", "true"),
+				),
+			},
+			// Look up by ID
+			{
+				Config: testAccProviderConfig(s) + testUserDataSource("id", acc.ID),
+				Check:  resource.TestCheckResourceAttr("data.mke_user.test", "name", "auditor"),
+			},
+			// Unknown users and organizations are errors
+			{
+				Config:      testAccProviderConfig(s) + testUserDataSource("name", "nobody"),
+				ExpectError: regexp.MustCompile(`No\s+MKE\s+user\s+nobody`),
+			},
+			{
+				Config:      testAccProviderConfig(s) + testUserDataSource("name", "someorg"),
+				ExpectError: regexp.MustCompile(`is\s+an\s+organization`),
+			},
+		},
+	})
+}
+
+func TestUserDataSourceNameOrID(t *testing.T) {
+	s := testAccFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(s) + `
+				data "mke_user" "test" {
+					id = "someid"
+					name = "admin"
+				}`,
+				ExpectError: regexp.MustCompile(`Invalid\s+Attribute\s+Combination`),
+			},
+			{
+				Config:      testAccProviderConfig(s) + `data "mke_user" "test" {}`,
+				ExpectError: regexp.MustCompile(`Invalid\s+Attribute\s+Combination`),
+			},
+		},
+	})
+}
+
+func testUserDataSource(attribute, value string) string {
+	return fmt.Sprintf(`
+	data "mke_user" "test" {
+		%s = %q
+	}`, attribute, value)
+}
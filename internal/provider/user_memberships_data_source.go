@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &UserMembershipsDataSource{}
+
+type UserMembershipsDataSourceModel struct {
+	User  types.String                         `tfsdk:"user"`
+	Id    types.String                         `tfsdk:"id"`
+	Orgs  []UserMembershipsDataSourceOrgModel  `tfsdk:"orgs"`
+	Teams []UserMembershipsDataSourceTeamModel `tfsdk:"teams"`
+}
+
+type UserMembershipsDataSourceOrgModel struct {
+	Id      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	IsAdmin types.Bool   `tfsdk:"is_admin"`
+}
+
+type UserMembershipsDataSourceTeamModel struct {
+	Id   types.String `tfsdk:"id"`
+	Org  types.String `tfsdk:"org"`
+	Name types.String `tfsdk:"name"`
+}
+
+type UserMembershipsDataSource struct {
+	providerModel MKEProviderModel
+}
+
+func NewUserMembershipsDataSource() datasource.DataSource {
+	return &UserMembershipsDataSource{}
+}
+
+func (d *UserMembershipsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_memberships"
+}
+
+func (d *UserMembershipsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The organizations and teams which an MKE user is a member of, e.g. to check that it is in no unexpected teams.",
+
+		Attributes: map[string]schema.Attribute{
+			"user": schema.StringAttribute{
+				MarkdownDescription: "The name or ID of the user",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user",
+				Computed:            true,
+			},
+			"orgs": schema.ListNestedAttribute{
+				MarkdownDescription: "The organizations of the user, sorted by name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the organization",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the organization",
+							Computed:            true,
+						},
+						"is_admin": schema.BoolAttribute{
+							MarkdownDescription: "Is the user an admin of the organization",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"teams": schema.ListNestedAttribute{
+				MarkdownDescription: "The teams of the user, sorted by organization and name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the team",
+							Computed:            true,
+						},
+						"org": schema.StringAttribute{
+							MarkdownDescription: "The name of the organization of the team",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the team",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UserMembershipsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	lpm, ok := req.ProviderData.(MKEProviderModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *MKEProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	tflog.Debug(ctx, "Successfully interpeted provider model", map[string]interface{}{})
+	d.providerModel = lpm
+}
+
+// Read lists the orgs of the user, then its teams in each of them.
+func (d *UserMembershipsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_user_memberships", "read")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data UserMembershipsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := d.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	user := data.User.ValueString()
+
+	acc, err := cl.ApiReadAccount(ctx, user)
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddError("User not found", fmt.Sprintf("No MKE user %s was found", user))
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Read user error", err.Error())
+		return
+	}
+	if acc.IsOrg {
+		resp.Diagnostics.AddError("Not a user", fmt.Sprintf("MKE account %s is an organization", user))
+		return
+	}
+	data.Id = types.StringValue(acc.ID)
+
+	memberOrgs, err := cl.ApiAccountOrgs(ctx, acc.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("List user orgs error", err.Error())
+		return
+	}
+	sort.Slice(memberOrgs, func(i, j int) bool { return memberOrgs[i].Org.Name < memberOrgs[j].Org.Name })
+
+	data.Orgs = []UserMembershipsDataSourceOrgModel{}
+	data.Teams = []UserMembershipsDataSourceTeamModel{}
+	for _, mo := range memberOrgs {
+		data.Orgs = append(data.Orgs, UserMembershipsDataSourceOrgModel{
+			Id:      types.StringValue(mo.Org.ID),
+			Name:    types.StringValue(mo.Org.Name),
+			IsAdmin: types.BoolValue(mo.IsAdmin),
+		})
+
+		teams, err := cl.ApiOrgMemberTeams(ctx, mo.Org.Name, acc.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("List user teams error", err.Error())
+			return
+		}
+		sort.Slice(teams, func(i, j int) bool { return teams[i].Name < teams[j].Name })
+
+		for _, t := range teams {
+			data.Teams = append(data.Teams, UserMembershipsDataSourceTeamModel{
+				Id:   types.StringValue(t.ID),
+				Org:  types.StringValue(mo.Org.Name),
+				Name: types.StringValue(t.Name),
+			})
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,67 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+func TestUserMembershipsDataSourceDefault(t *testing.T) {
+	s := testAccFakeServer(t)
+	acc := s.CreateAccount(client.CreateAccount{Name: "user1", Password: "password", IsActive: true})
+	loner := s.CreateAccount(client.CreateAccount{Name: "loner", Password: "password", IsActive: true})
+	for _, org := range []string{"orgb", "orga", "orgc"} {
+		s.CreateAccount(client.CreateAccount{Name: org, IsOrg: true})
+		s.CreateTeam(org, client.CreateTeam{Name: "devs"})
+		s.CreateTeam(org, client.CreateTeam{Name: "admins"})
+	}
+	s.SetOrgMember("orga", "user1", true)
+	s.SetTeamMember("orgb", "devs", "user1", false)
+	s.SetTeamMember("orgb", "admins", "user1", false)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(s) + testUserMembershipsDataSource("user1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.mke_user_memberships.test", "id", acc.ID),
+					resource.TestCheckResourceAttr("data.mke_user_memberships.test", "orgs.#", "2"),
+					resource.TestCheckResourceAttr("data.mke_user_memberships.test", "orgs.0.name", "orga"),
+					resource.TestCheckResourceAttr("data.mke_user_memberships.test", "orgs.0.is_admin", "true"),
+					resource.TestCheckResourceAttr("data.mke_user_memberships.test", "orgs.1.name", "orgb"),
+					resource.TestCheckResourceAttr("data.mke_user_memberships.test", "orgs.1.is_admin", "false"),
+					resource.TestCheckResourceAttr("data.mke_user_memberships.test", "teams.#", "2"),
+					resource.TestCheckResourceAttr("data.mke_user_memberships.test", "teams.0.org", "orgb"),
+					resource.TestCheckResourceAttr("data.mke_user_memberships.test", "teams.0.name", "admins"),
+					resource.TestCheckResourceAttr("data.mke_user_memberships.test", "teams.1.name", "devs"),
+				),
+			},
+			// By ID, for a user without memberships
+			{
+				Config: testAccProviderConfig(s) + testUserMembershipsDataSource(loner.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.mke_user_memberships.test", "id", loner.ID),
+					resource.TestCheckResourceAttr("data.mke_user_memberships.test", "orgs.#", "0"),
+					resource.TestCheckResourceAttr("data.mke_user_memberships.test", "teams.#", "0"),
+				),
+			},
+			{
+				Config:      testAccProviderConfig(s) + testUserMembershipsDataSource("orga"),
+				ExpectError: regexp.MustCompile(`is\s+an\s+organization`),
+			},
+		},
+	})
+}
+
+func testUserMembershipsDataSource(user string) string {
+	return fmt.Sprintf(`
+	data "mke_user_memberships" "test" {
+		user = %q
+	}`, user)
+}
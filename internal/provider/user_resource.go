@@ -5,29 +5,128 @@ import (
 	"fmt"
 
 	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+const (
+	// UserAuthSourceManaged users with a password managed by MKE.
+	UserAuthSourceManaged = "managed"
+	// UserAuthSourceLDAP users looked up in LDAP, who log in with their LDAP password.
+	UserAuthSourceLDAP = "ldap"
+
+	// UserDeletionPolicyDelete delete the account from MKE on destroy.
+	UserDeletionPolicyDelete = "delete"
+	// UserDeletionPolicyDeactivate deactivate the account and revoke its public keys on destroy.
+	UserDeletionPolicyDeactivate = "deactivate"
+	// UserDeletionPolicyRetain leave the account in MKE on destroy.
+	UserDeletionPolicyRetain = "retain"
+)
+
 var _ resource.Resource = &UserResource{}
+var _ resource.ResourceWithValidateConfig = &UserResource{}
+var _ resource.ResourceWithModifyPlan = &UserResource{}
 
 type UserResourceModel struct {
-	Name     types.String `tfsdk:"name"`
-	Password types.String `tfsdk:"password"`
-	FullName types.String `tfsdk:"full_name"`
-	IsAdmin  types.Bool   `tfsdk:"is_admin"`
-	IsActive types.Bool   `tfsdk:"is_active"`
-	Id       types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Password   types.String `tfsdk:"password"`
+	FullName   types.String `tfsdk:"full_name"`
+	IsAdmin    types.Bool   `tfsdk:"is_admin"`
+	IsActive   types.Bool   `tfsdk:"is_active"`
+	AuthSource types.String `tfsdk:"auth_source"`
+	IsImported types.Bool   `tfsdk:"is_imported"`
+	OnDemand   types.Bool   `tfsdk:"on_demand"`
+	OtpEnabled types.Bool   `tfsdk:"otp_enabled"`
+	Id         types.String `tfsdk:"id"`
+
+	PasswordWO        types.String             `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64              `tfsdk:"password_wo_version"`
+	GeneratedPassword types.String             `tfsdk:"generated_password"`
+	PasswordPolicy    *UserPasswordPolicyModel `tfsdk:"password_policy"`
+	Keepers           types.Map                `tfsdk:"keepers"`
+
+	DeletionPolicy types.String `tfsdk:"deletion_policy"`
+	ResetOTP       types.String `tfsdk:"reset_otp"`
+}
+
+type UserPasswordPolicyModel struct {
+	Length          types.Int64  `tfsdk:"length"`
+	Upper           types.Bool   `tfsdk:"upper"`
+	Lower           types.Bool   `tfsdk:"lower"`
+	Numeric         types.Bool   `tfsdk:"numeric"`
+	Special         types.Bool   `tfsdk:"special"`
+	MinUpper        types.Int64  `tfsdk:"min_upper"`
+	MinLower        types.Int64  `tfsdk:"min_lower"`
+	MinNumeric      types.Int64  `tfsdk:"min_numeric"`
+	MinSpecial      types.Int64  `tfsdk:"min_special"`
+	OverrideSpecial types.String `tfsdk:"override_special"`
+	Exclude         types.String `tfsdk:"exclude"`
+}
+
+// PasswordPolicy convert the model to a client password policy, with the defaults for anything not set.
+// A nil model is the default policy.
+func (m *UserPasswordPolicyModel) PasswordPolicy() client.PasswordPolicy {
+	policy := client.DefaultPasswordPolicy()
+	if m == nil {
+		return policy
+	}
+
+	boolOr := func(v types.Bool, d bool) bool {
+		if v.IsNull() || v.IsUnknown() {
+			return d
+		}
+		return v.ValueBool()
+	}
+
+	if !m.Length.IsNull() && !m.Length.IsUnknown() {
+		policy.Length = int(m.Length.ValueInt64())
+	}
+	policy.Upper = boolOr(m.Upper, policy.Upper)
+	policy.Lower = boolOr(m.Lower, policy.Lower)
+	policy.Numeric = boolOr(m.Numeric, policy.Numeric)
+	policy.Special = boolOr(m.Special, policy.Special)
+	policy.MinUpper = int(m.MinUpper.ValueInt64())
+	policy.MinLower = int(m.MinLower.ValueInt64())
+	policy.MinNumeric = int(m.MinNumeric.ValueInt64())
+	policy.MinSpecial = int(m.MinSpecial.ValueInt64())
+	policy.OverrideSpecial = m.OverrideSpecial.ValueString()
+	policy.Exclude = m.Exclude.ValueString()
+
+	return policy
+}
+
+// setAccount refresh the model from every field of the account in MKE.
+func (m *UserResourceModel) setAccount(acc client.ResponseAccount) {
+	m.Id = types.StringValue(acc.ID)
+	m.Name = types.StringValue(acc.Name)
+	m.FullName = types.StringValue(acc.FullName)
+	m.IsAdmin = types.BoolValue(acc.IsAdmin)
+	m.IsActive = types.BoolValue(acc.IsActive)
+	m.AuthSource = types.StringValue(authSource(acc))
+	m.IsImported = types.BoolValue(acc.IsImported)
+	m.OnDemand = types.BoolValue(acc.OnDemand)
+	m.OtpEnabled = types.BoolValue(acc.OtpEnabled)
+}
+
+// authSource the auth source of an account.
+func authSource(acc client.ResponseAccount) string {
+	if acc.IsImported {
+		return UserAuthSourceLDAP
+	}
+	return UserAuthSourceManaged
 }
 
 type UserResource struct {
@@ -55,15 +154,45 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the user",
+				MarkdownDescription: "The name of the user. MKE can't rename users, so changing it creates a new user",
 				Required:            true,
 				Validators:          []validator.String{stringvalidator.LengthBetween(3, 16)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "The password of the user",
-				Required:            true,
+				MarkdownDescription: "The password of the user, not allowed for `ldap` users. If it is not set for a `managed` user, " +
+					"one is generated from the `password_policy`. Changing it resets the password, which needs an admin provider account, " +
+					"unless the user is the provider account itself",
+				Optional:   true,
+				Sensitive:  true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(8)},
+			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: "The password of the user, as an alternative to `password`. It is write-only, so it is never stored in state, " +
+					"and it is only sent when the user is created or `password_wo_version` changes. Requires Terraform 1.11 or later.",
+				Optional:   true,
+				Sensitive:  true,
+				WriteOnly:  true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(8)},
+			},
+			"password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Change this value to send a new `password_wo` to MKE",
+				Optional:            true,
+			},
+			"generated_password": schema.StringAttribute{
+				MarkdownDescription: "The password generated for a `managed` user without a `password` or `password_wo`. It is regenerated when the `password_policy` or the `keepers` change",
+				Computed:            true,
 				Sensitive:           true,
-				Validators:          []validator.String{stringvalidator.LengthBetween(8, 16)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"keepers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values which regenerate the `generated_password` when they change, to rotate it",
+				ElementType:         types.StringType,
+				Optional:            true,
 			},
 			"full_name": schema.StringAttribute{
 				MarkdownDescription: "The full name of the user",
@@ -83,6 +212,111 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Default:             booldefault.StaticBool(true),
 				Optional:            true,
 			},
+			"auth_source": schema.StringAttribute{
+				MarkdownDescription: "How the user logs in: `managed` users have a password in MKE, " +
+					"`ldap` users are looked up in LDAP and log in with their LDAP password. Changing the auth source creates a new user.",
+				Computed:   true,
+				Optional:   true,
+				Default:    stringdefault.StaticString(UserAuthSourceManaged),
+				Validators: []validator.String{stringvalidator.OneOf(UserAuthSourceManaged, UserAuthSourceLDAP)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"deletion_policy": schema.StringAttribute{
+				MarkdownDescription: "What happens to the user in MKE when the resource is destroyed or replaced: " +
+					"`delete` deletes the account, which can't be undone and orphans its grants, keys and audit history, " +
+					"`deactivate` deactivates the account and revokes its public keys, and `retain` leaves the account as it is. " +
+					"Only users with the `delete` policy can change their `auth_source`, as the new user has the same name",
+				Computed:   true,
+				Optional:   true,
+				Default:    stringdefault.StaticString(UserDeletionPolicyDelete),
+				Validators: []validator.String{stringvalidator.OneOf(UserDeletionPolicyDelete, UserDeletionPolicyDeactivate, UserDeletionPolicyRetain)},
+			},
+			"is_imported": schema.BoolAttribute{
+				MarkdownDescription: "Was the user imported from LDAP",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"on_demand": schema.BoolAttribute{
+				MarkdownDescription: "Was the user created on demand, when they first logged in",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"otp_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Does the user log in with a one-time password as a second factor",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reset_otp": schema.StringAttribute{
+				MarkdownDescription: "Change this value to reset the two-factor authentication of the user, e.g. when they lost their device, " +
+					"so that they can log in with their password and set up a new device. Using a ticket reference or a date keeps a record of the reset. " +
+					"Only admins can reset other users",
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"password_policy": schema.SingleNestedBlock{
+				MarkdownDescription: "How the `generated_password` is generated, when no `password` is set. " +
+					"Passwords are generated with a cryptographically secure random source.",
+				Attributes: map[string]schema.Attribute{
+					"length": schema.Int64Attribute{
+						MarkdownDescription: fmt.Sprintf("The length of the password. Defaults to `%d`", client.DefaultPasswordLength),
+						Optional:            true,
+						Validators:          []validator.Int64{int64validator.AtLeast(8)},
+					},
+					"upper": schema.BoolAttribute{
+						MarkdownDescription: "Use upper case letters. Defaults to `true`",
+						Optional:            true,
+					},
+					"lower": schema.BoolAttribute{
+						MarkdownDescription: "Use lower case letters. Defaults to `true`",
+						Optional:            true,
+					},
+					"numeric": schema.BoolAttribute{
+						MarkdownDescription: "Use numbers. Defaults to `true`",
+						Optional:            true,
+					},
+					"special": schema.BoolAttribute{
+						MarkdownDescription: fmt.Sprintf("Use special characters, `%s` unless `override_special` is set. Defaults to `true`", client.PasswordCharsSpecial),
+						Optional:            true,
+					},
+					"min_upper": schema.Int64Attribute{
+						MarkdownDescription: "The minimum number of upper case letters",
+						Optional:            true,
+						Validators:          []validator.Int64{int64validator.AtLeast(0)},
+					},
+					"min_lower": schema.Int64Attribute{
+						MarkdownDescription: "The minimum number of lower case letters",
+						Optional:            true,
+						Validators:          []validator.Int64{int64validator.AtLeast(0)},
+					},
+					"min_numeric": schema.Int64Attribute{
+						MarkdownDescription: "The minimum number of numbers",
+						Optional:            true,
+						Validators:          []validator.Int64{int64validator.AtLeast(0)},
+					},
+					"min_special": schema.Int64Attribute{
+						MarkdownDescription: "The minimum number of special characters",
+						Optional:            true,
+						Validators:          []validator.Int64{int64validator.AtLeast(0)},
+					},
+					"override_special": schema.StringAttribute{
+						MarkdownDescription: "The special characters to use instead of the default ones",
+						Optional:            true,
+					},
+					"exclude": schema.StringAttribute{
+						MarkdownDescription: "Characters which are never used in the password, e.g. ones that are hard to tell apart like `l1IO0`",
+						Optional:            true,
+					},
+				},
+			},
 		},
 		MarkdownDescription: "User resource",
 	}
@@ -109,16 +343,206 @@ func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequ
 	r.providerModel = lpm
 }
 
+// ValidateConfig check that the password matches the auth source, and that the password policy can generate a password.
+func (r *UserResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AuthSource.ValueString() == UserAuthSourceLDAP {
+		if !data.Password.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("password"), "Invalid user configuration", "LDAP users log in with their LDAP password, so they can't have a password in MKE.")
+		}
+		if !data.PasswordWO.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("password_wo"), "Invalid user configuration", "LDAP users log in with their LDAP password, so they can't have a password in MKE.")
+		}
+		if data.PasswordPolicy != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("password_policy"), "Invalid user configuration", "LDAP users log in with their LDAP password, so no password is generated for them.")
+		}
+		return
+	}
+
+	if !data.Password.IsNull() && !data.PasswordWO.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("password_wo"), "Invalid user configuration", "Only one of password and password_wo can be set.")
+	}
+	if !data.PasswordWOVersion.IsNull() && data.PasswordWO.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("password_wo_version"), "Invalid user configuration", "password_wo_version is only used with password_wo.")
+	}
+
+	if data.PasswordPolicy == nil {
+		return
+	}
+	if !data.Password.IsNull() || !data.PasswordWO.IsNull() {
+		resp.Diagnostics.AddAttributeWarning(path.Root("password_policy"), "Password policy not used", "The password policy is only used when no password is set.")
+		return
+	}
+
+	// try the policy, once all of its values are known
+	p := data.PasswordPolicy
+	for _, v := range []attr.Value{p.Length, p.Upper, p.Lower, p.Numeric, p.Special, p.MinUpper, p.MinLower, p.MinNumeric, p.MinSpecial, p.OverrideSpecial, p.Exclude} {
+		if v.IsUnknown() {
+			return
+		}
+	}
+	if _, err := client.GeneratePassword(p.PasswordPolicy()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("password_policy"), "Invalid password policy", err.Error())
+	}
+}
+
+// ModifyPlan plan a new generated password when there is no password and the policy or the keepers change,
+// or a password is removed, and no generated password when a password, or a write-only password, is set.
+// Imported users keep their existing password until the keepers or policy change after the import.
+// Destroying or replacing a user warns about what its deletion policy does.
+func (r *UserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// new users get a generated password on create
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state UserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(deletionPolicyWarning(state)...)
+		return
+	}
+
+	var plan, config UserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authSourceChanged := !plan.AuthSource.IsUnknown() && !plan.AuthSource.Equal(state.AuthSource)
+
+	// replacing the user with the same name only works if the old account is deleted first
+	if authSourceChanged && plan.Name.Equal(state.Name) && state.DeletionPolicy.ValueString() != UserDeletionPolicyDelete {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auth_source"),
+			"User can't be replaced",
+			fmt.Sprintf("Changing the auth source of user %s replaces it, but its deletion policy is %s, so the account is left in MKE "+
+				"and a new user with the same name can't be created. Apply deletion_policy = \"delete\" first, then change the auth source.",
+				state.Name.ValueString(), state.DeletionPolicy.ValueString()),
+		)
+		return
+	}
+
+	if !plan.Name.Equal(state.Name) || authSourceChanged {
+		resp.Diagnostics.Append(deletionPolicyWarning(state)...)
+	}
+
+	if resetOTP(plan, state) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("otp_enabled"), types.BoolValue(false))...)
+	} else if plan.ResetOTP.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("otp_enabled"), types.BoolUnknown())...)
+	}
+
+	generatedPath := path.Root("generated_password")
+
+	switch {
+	case !plan.Password.IsNull() || !config.PasswordWO.IsNull() || plan.AuthSource.ValueString() == UserAuthSourceLDAP:
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, generatedPath, types.StringNull())...)
+	case passwordRemoved(state),
+		keepersChanged(plan, state),
+		passwordPolicyChanged(plan, state):
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, generatedPath, types.StringUnknown())...)
+	default:
+		// UseStateForUnknown leaves a null generated password unknown, which would look like a rotation
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, generatedPath, state.GeneratedPassword)...)
+	}
+}
+
+// importedPassword is the password of the user unknown to terraform, as it was imported and has not been set since.
+func importedPassword(state UserResourceModel) bool {
+	return state.GeneratedPassword.IsNull() && state.Password.IsNull() && state.PasswordWOVersion.IsNull()
+}
+
+// passwordRemoved was a password, or a write-only password, set before, so a password is generated instead.
+func passwordRemoved(state UserResourceModel) bool {
+	return state.GeneratedPassword.IsNull() && !importedPassword(state)
+}
+
+// keepersChanged do the keepers rotate the generated password.
+// Setting keepers on an imported user starts tracking them, without replacing its existing password.
+func keepersChanged(plan, state UserResourceModel) bool {
+	if importedPassword(state) && state.Keepers.IsNull() {
+		return false
+	}
+	return !plan.Keepers.Equal(state.Keepers)
+}
+
+// passwordPolicyChanged does the password policy rotate the generated password.
+// Setting a policy on an imported user starts tracking it, without replacing its existing password.
+func passwordPolicyChanged(plan, state UserResourceModel) bool {
+	if importedPassword(state) && state.PasswordPolicy == nil {
+		return false
+	}
+	return plan.PasswordPolicy.PasswordPolicy() != state.PasswordPolicy.PasswordPolicy()
+}
+
+// resetOTP does the plan reset the two-factor authentication of the user.
+func resetOTP(plan, state UserResourceModel) bool {
+	return !plan.ResetOTP.IsNull() && !plan.ResetOTP.IsUnknown() && !plan.ResetOTP.Equal(state.ResetOTP)
+}
+
+// deletionPolicyWarning what happens to the user in MKE when it is destroyed, with its deletion policy.
+func deletionPolicyWarning(state UserResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	name := state.Name.ValueString()
+
+	switch state.DeletionPolicy.ValueString() {
+	case UserDeletionPolicyRetain:
+		diags.AddWarning("User will be retained in MKE",
+			fmt.Sprintf("The deletion policy of user %s is retain, so it is only removed from the terraform state, and left as it is in MKE.", name))
+	case UserDeletionPolicyDeactivate:
+		diags.AddWarning("User will be deactivated in MKE",
+			fmt.Sprintf("The deletion policy of user %s is deactivate, so the account is deactivated and its public keys are revoked, "+
+				"but it is not deleted from MKE.", name))
+	default:
+		diags.AddWarning("User will be deleted from MKE",
+			fmt.Sprintf("The deletion policy of user %s is delete, so the account is deleted from MKE. This can't be undone, and orphans "+
+				"its grants, keys and audit history. Set deletion_policy to deactivate to keep the account.", name))
+	}
+
+	return diags
+}
+
 func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data *UserResourceModel
+	ctx, span := startOperationSpan(ctx, "mke_user", "create")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data, config *UserResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan data into the model, and the write-only password from the config
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	isLDAP := data.AuthSource.ValueString() == UserAuthSourceLDAP
 
 	pass := data.Password.ValueString()
-	if pass == "" {
-		pass = client.GeneratePass()
-		data.Password = basetypes.NewStringValue(pass)
+	if !config.PasswordWO.IsNull() {
+		pass = config.PasswordWO.ValueString()
+	}
+	data.GeneratedPassword = types.StringNull()
+	if pass == "" && !isLDAP {
+		generated, err := client.GeneratePassword(data.PasswordPolicy.PasswordPolicy())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("password_policy"), "Generate password error", err.Error())
+			return
+		}
+		pass = generated
+		data.GeneratedPassword = types.StringValue(generated)
 	}
 
 	acc := client.CreateAccount{
@@ -128,7 +552,7 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		IsAdmin:    data.IsAdmin.ValueBool(),
 		IsActive:   data.IsActive.ValueBool(),
 		IsOrg:      false,
-		SearchLDAP: false,
+		SearchLDAP: isLDAP,
 	}
 
 	if resp.Diagnostics.HasError() {
@@ -140,25 +564,24 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
 		return
 	}
-	if r.providerModel.TestingMode() {
-		resp.Diagnostics.AddWarning("testing mode warning", "mke user resource handler is in testing mode, no creation will be run.")
-		data.Id = basetypes.NewStringValue(TestingVersion)
-	} else {
-		rAcc, err := cl.ApiCreateAccount(ctx, acc)
-		if err != nil {
-			resp.Diagnostics.AddError("Create account error", err.Error())
-			return
-		}
-
-		tflog.Trace(ctx, fmt.Sprintf("created User resource `%s`", data.Name.ValueString()))
 
-		data.Id = basetypes.NewStringValue(rAcc.ID)
+	rAcc, err := cl.ApiCreateAccount(ctx, acc)
+	if err != nil {
+		resp.Diagnostics.AddError("Create account error", err.Error())
+		return
 	}
 
+	tflog.Trace(ctx, fmt.Sprintf("created User resource `%s`", data.Name.ValueString()))
+
+	data.setAccount(rAcc)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_user", "read")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
 	tflog.Debug(ctx, "Preparing to read user resource")
 	var data *UserResourceModel
 
@@ -174,19 +597,22 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
 		return
 	}
-	if r.providerModel.TestingMode() {
-		resp.Diagnostics.AddWarning("testing mode warning", "mke user resource handler is in testing mode, no read will be run.")
-		data.Id = types.StringValue(TestingVersion)
-	} else {
-		rAcc, err := cl.ApiReadAccount(ctx, data.Name.ValueString())
-		if err != nil {
-			resp.Diagnostics.AddError("Read account error", err.Error())
-			return
-		}
-		data.Id = types.StringValue(rAcc.ID)
-		data.Name = types.StringValue(rAcc.Name)
-		data.FullName = types.StringValue(rAcc.FullName)
-		data.IsAdmin = types.BoolValue(rAcc.IsAdmin)
+
+	// the ID, or the name or ID which was imported
+	rAcc, err := cl.ApiReadAccount(ctx, data.Id.ValueString())
+	if client.IsNotFound(err) {
+		// the account was removed outside of terraform, so it should be removed from state
+		resp.Diagnostics.AddWarning("User in state not found in MKE API", err.Error())
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Read account error", err.Error())
+		return
+	}
+	data.setAccount(rAcc)
+	if data.DeletionPolicy.IsNull() {
+		// imported users get the default
+		data.DeletionPolicy = types.StringValue(UserDeletionPolicyDelete)
 	}
 
 	// Save updated data into Terraform state
@@ -194,12 +620,17 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_user", "update")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
 	tflog.Debug(ctx, "Preparing to update user resource")
 
-	var data *UserResourceModel
+	var data, state, config *UserResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -209,35 +640,108 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
 		return
 	}
-	if r.providerModel.TestingMode() {
-		resp.Diagnostics.AddWarning("testing mode warning", "mke user resource handler is in testing mode, no update will be run.")
-		data.Id = types.StringValue(TestingVersion)
-	} else {
-		user := client.UpdateAccount{
-			FullName: data.FullName.ValueString(),
-			IsAdmin:  data.IsAdmin.ValueBool(),
-		}
-		rAcc, err := cl.ApiUpdateAccount(ctx, data.Id.ValueString(), user)
-		tflog.Debug(ctx, fmt.Sprintf("The retuerned 'user' %+v", rAcc))
 
+	newPassword := ""
+	if data.GeneratedPassword.IsUnknown() {
+		generated, err := client.GeneratePassword(data.PasswordPolicy.PasswordPolicy())
 		if err != nil {
-			resp.Diagnostics.AddError("Update account error", err.Error())
+			resp.Diagnostics.AddAttributeError(path.Root("password_policy"), "Generate password error", err.Error())
+			return
+		}
+		newPassword = generated
+		data.GeneratedPassword = types.StringValue(generated)
+	} else if !data.Password.IsNull() && !data.Password.Equal(state.Password) {
+		newPassword = data.Password.ValueString()
+	} else if !config.PasswordWO.IsNull() && !data.PasswordWOVersion.Equal(state.PasswordWOVersion) {
+		newPassword = config.PasswordWO.ValueString()
+	}
+
+	if newPassword != "" {
+		resp.Diagnostics.Append(r.changePassword(ctx, cl, data.Name.ValueString(), newPassword)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if resetOTP(*data, *state) {
+		if err := cl.ApiResetAccountOTP(ctx, data.Id.ValueString()); client.IsForbidden(err) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("reset_otp"),
+				"Reset OTP not allowed",
+				fmt.Sprintf("The provider account %s is not an MKE admin, so it can't reset the OTP of %s.\n\n%s", cl.Username(), data.Name.ValueString(), err.Error()),
+			)
+			return
+		} else if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("reset_otp"), "Reset OTP error", err.Error())
 			return
 		}
+		tflog.Info(ctx, "Reset the OTP of user", map[string]any{"name": data.Name.ValueString(), "reset_otp": data.ResetOTP.ValueString()})
+	}
+
+	// only send what changed, as only admins can send the admin and active flags
+	user := client.UpdateAccount{}
+	if !data.FullName.Equal(state.FullName) {
+		user.FullName = client.Ptr(data.FullName.ValueString())
+	}
+	if !data.IsActive.Equal(state.IsActive) {
+		user.IsActive = client.Ptr(data.IsActive.ValueBool())
+	}
+	if !data.IsAdmin.Equal(state.IsAdmin) {
+		user.IsAdmin = client.Ptr(data.IsAdmin.ValueBool())
+	}
+	rAcc, err := cl.ApiUpdateAccount(ctx, data.Id.ValueString(), user)
+	tflog.Debug(ctx, fmt.Sprintf("The retuerned 'user' %+v", rAcc))
 
-		// Overwrite user with refreshed state
-		data.Id = types.StringValue(rAcc.ID)
-		data.Name = types.StringValue(rAcc.Name)
-		data.FullName = types.StringValue(rAcc.FullName)
-		data.IsAdmin = types.BoolValue(rAcc.IsAdmin)
+	if err != nil {
+		resp.Diagnostics.AddError("Update account error", err.Error())
+		return
 	}
 
+	// Overwrite user with refreshed state
+	data.setAccount(rAcc)
+
 	// Set refreshed state
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 	tflog.Debug(ctx, "Updated 'user' resource", map[string]any{"success": true})
 }
 
+// changePassword set a new password for the user. The provider account changes its own password
+// with its current one, while the passwords of other users are reset, which only admins can do.
+func (r *UserResource) changePassword(ctx context.Context, cl client.Client, name, password string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if name == cl.Username() {
+		if _, err := cl.ApiChangePassword(ctx, name, r.providerModel.Password.ValueString(), password); err != nil {
+			diags.AddAttributeError(path.Root("password"), "Change password error", err.Error())
+			return diags
+		}
+
+		diags.AddAttributeWarning(
+			path.Root("password"),
+			"Provider password changed",
+			fmt.Sprintf("The password of %s, which the provider logs in as, was changed. Update the provider password before the next run.", name),
+		)
+		return diags
+	}
+
+	if _, err := cl.ApiResetPassword(ctx, name, password); client.IsForbidden(err) {
+		diags.AddAttributeError(
+			path.Root("password"),
+			"Reset password not allowed",
+			fmt.Sprintf("The provider account %s is not an MKE admin, so it can't reset the password of %s. "+
+				"Configure the provider with an admin account, or as %s to change their own password.\n\n%s", cl.Username(), name, name, err.Error()),
+		)
+	} else if err != nil {
+		diags.AddAttributeError(path.Root("password"), "Reset password error", err.Error())
+	}
+
+	return diags
+}
+
 func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_user", "delete")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
 	var data *UserResourceModel
 
 	// Read Terraform prior state data into the model
@@ -247,9 +751,19 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
 		return
 	}
-	if r.providerModel.TestingMode() {
-		resp.Diagnostics.AddWarning("testing mode warning", "mke user resource handler is in testing mode, no deletion will be run.")
-	} else if err := cl.ApiDeleteAccount(ctx, data.Id.ValueString()); err != nil {
+
+	switch data.DeletionPolicy.ValueString() {
+	case UserDeletionPolicyRetain:
+		tflog.Debug(ctx, "Retaining user in MKE", map[string]any{"id": data.Id.ValueString()})
+		return
+	case UserDeletionPolicyDeactivate:
+		resp.Diagnostics.Append(deactivateUser(ctx, cl, data.Id.ValueString())...)
+		return
+	}
+
+	if err := cl.ApiDeleteAccount(ctx, data.Id.ValueString()); client.IsNotFound(err) {
+		tflog.Debug(ctx, "User was already removed from MKE", map[string]any{"id": data.Id.ValueString()})
+	} else if err != nil {
 		resp.Diagnostics.AddError("Delete account error", err.Error())
 		return
 	}
@@ -257,6 +771,34 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	tflog.Debug(ctx, "Deleted user resource", map[string]any{"success": true})
 }
 
+// deactivateUser deactivate the account and revoke all of its public keys, so that it can't be used.
+func deactivateUser(ctx context.Context, cl client.Client, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if _, err := cl.ApiUpdateAccount(ctx, id, client.UpdateAccount{IsActive: client.Ptr(false)}); client.IsNotFound(err) {
+		tflog.Debug(ctx, "User was already removed from MKE", map[string]any{"id": id})
+		return diags
+	} else if err != nil {
+		diags.AddError("Deactivate account error", err.Error())
+		return diags
+	}
+
+	keys, err := cl.ApiPublicKeyList(ctx, id)
+	if err != nil {
+		diags.AddError("Revoke public keys error", err.Error())
+		return diags
+	}
+	for _, key := range keys {
+		if err := cl.ApiPublicKeyDelete(ctx, id, key.ID); err != nil && !client.IsNotFound(err) {
+			diags.AddError("Revoke public keys error", err.Error())
+		}
+	}
+
+	tflog.Debug(ctx, "Deactivated user", map[string]any{"id": id, "revoked_keys": len(keys)})
+	return diags
+}
+
 func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+	// the ID or the name of the user, Read looks up either and sets the ID
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -1,55 +1,76 @@
 package provider_test
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
-)
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 
-const (
-	providerConfig = `
-	provider "mke" {
-		endpoint = "test"
-		username = "test"
-		password = "test"
-	}`
-)
-
-const (
-	TestingVersion = "test"
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/Mirantis/terraform-provider-mke/internal/mketest"
 )
 
 func TestUserResourceDefault(t *testing.T) {
+	s := testAccFakeServer(t)
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroyed(s, "test"),
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: providerConfig + testUserResourceDefault(),
+				Config: testAccProviderConfig(s) + testUserResourceDefault(),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("mke_user.test", "name", TestingVersion),
+					resource.TestCheckResourceAttr("mke_user.test", "name", "test"),
+					resource.TestCheckResourceAttrSet("mke_user.test", "id"),
+					resource.TestCheckResourceAttr("mke_user.test", "auth_source", "managed"),
+					resource.TestCheckResourceAttr("mke_user.test", "is_imported", "false"),
+					testAccCheckUserInMKE(s, "test", "test", false),
 				),
 			},
 			// ImportState testing
 			{
-				ResourceName: "mke_user.test",
-				ImportState:  true,
+				ResourceName:            "mke_user.test",
+				ImportState:             true,
+				ImportStateId:           "test",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
 			},
 			// Update and Read testing
 			{
-				Config: providerConfig + `
+				Config: testAccProviderConfig(s) + `
 				resource "mke_user" "test" {
-				name = "blah"
-				password = "blahblah"
+				name = "test"
+				password = "testtest"
 				full_name = "blah"
+				is_admin = true
 			}`,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("mke_user.test", "name", "blah"),
-					resource.TestCheckResourceAttr("mke_user.test", "password", "blahblah"),
+					resource.TestCheckResourceAttr("mke_user.test", "name", "test"),
 					resource.TestCheckResourceAttr("mke_user.test", "full_name", "blah"),
-					resource.TestCheckResourceAttr("mke_user.test", "is_admin", "false"),
+					resource.TestCheckResourceAttr("mke_user.test", "is_admin", "true"),
 					resource.TestCheckResourceAttrSet("mke_user.test", "id"),
+					testAccCheckUserInMKE(s, "test", "blah", true),
+				),
+			},
+			// Removed outside of terraform, so it is recreated
+			{
+				PreConfig: func() { s.RemoveAccount("test") },
+				Config: testAccProviderConfig(s) + `
+				resource "mke_user" "test" {
+				name = "test"
+				password = "testtest"
+				full_name = "blah"
+				is_admin = true
+			}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserInMKE(s, "test", "blah", true),
 				),
 			},
 			// Delete is called implicitly
@@ -57,6 +78,700 @@ func TestUserResourceDefault(t *testing.T) {
 	})
 }
 
+func TestUserResourceDrift(t *testing.T) {
+	s := testAccFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroyed(s, "renamed"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(s) + testUserResourceFlags("test", true, true),
+				Check:  testAccCheckUserFlags(s, "test", true, true),
+			},
+			// Flags can be set back to false
+			{
+				Config: testAccProviderConfig(s) + testUserResourceFlags("test", false, false),
+				Check:  testAccCheckUserFlags(s, "test", false, false),
+			},
+			// Changes outside of terraform are detected and reverted
+			{
+				PreConfig: func() {
+					s.ModifyAccount("test", func(acc *client.ResponseAccount) {
+						acc.IsActive = true
+						acc.IsAdmin = true
+						acc.FullName = "changed"
+					})
+				},
+				Config: testAccProviderConfig(s) + testUserResourceFlags("test", false, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("mke_user.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserFlags(s, "test", false, false),
+					testAccCheckUserInMKE(s, "test", "Flag Test", false),
+				),
+			},
+			// Import by ID
+			{
+				ResourceName: "mke_user.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(st *terraform.State) (string, error) {
+					return st.RootModule().Resources["mke_user.test"].Primary.ID, nil
+				},
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			// Renaming creates a new user
+			{
+				Config: testAccProviderConfig(s) + testUserResourceFlags("renamed", false, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("mke_user.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserDestroyed(s, "test"),
+					testAccCheckUserFlags(s, "renamed", false, false),
+				),
+			},
+		},
+	})
+}
+
+func TestUserResourceImportNoChanges(t *testing.T) {
+	s := testAccFakeServer(t)
+	s.CreateAccount(client.CreateAccount{Name: "test", Password: "testtest", FullName: "Flag Test", IsActive: true, IsAdmin: true})
+
+	config := testAccProviderConfig(s) + `
+	resource "mke_user" "test" {
+		name = "test"
+		full_name = "Flag Test"
+		is_active = true
+		is_admin = true
+	}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:             config,
+				ResourceName:       "mke_user.test",
+				ImportState:        true,
+				ImportStateId:      "test",
+				ImportStatePersist: true,
+			},
+			// The config matches the imported user, so nothing changes
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserPassword(s, "test", "testtest"),
+					testAccCheckUserPasswordChanges(s, "test", 0),
+				),
+			},
+		},
+	})
+}
+
+func testUserResourceFlags(name string, isActive, isAdmin bool) string {
+	return fmt.Sprintf(`
+	resource "mke_user" "test" {
+		name = "%s"
+		password = "testtest"
+		full_name = "Flag Test"
+		is_active = %t
+		is_admin = %t
+	}`, name, isActive, isAdmin)
+}
+
+// testAccCheckUserFlags confirm the active and admin flags of an account in the fake MKE server.
+func testAccCheckUserFlags(s *mketest.Server, name string, isActive, isAdmin bool) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		acc, ok := s.Account(name)
+		if !ok {
+			return fmt.Errorf("account %s does not exist in MKE", name)
+		}
+		if acc.IsActive != isActive || acc.IsAdmin != isAdmin {
+			return fmt.Errorf("account %s has active %t and admin %t, expected %t and %t", name, acc.IsActive, acc.IsAdmin, isActive, isAdmin)
+		}
+		return nil
+	}
+}
+
+// testAccCheckUserPasswordChanges confirm how many times the password of an account was changed in the fake MKE server.
+func testAccCheckUserPasswordChanges(s *mketest.Server, name string, expected int) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if changes := s.PasswordChanges(name); changes != expected {
+			return fmt.Errorf("account %s had %d password changes, expected %d", name, changes, expected)
+		}
+		return nil
+	}
+}
+
+func TestUserResourceDeletionPolicy(t *testing.T) {
+	s := testAccFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckUserFlags(s, "retained", true, false),
+			testAccCheckUserFlags(s, "deactivated", false, false),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(s) + `
+				resource "mke_user" "retained" {
+					name = "retained"
+					password = "testtest"
+					deletion_policy = "retain"
+				}
+				resource "mke_user" "deactivated" {
+					name = "deactivated"
+					password = "testtest"
+					deletion_policy = "deactivate"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_user.retained", "deletion_policy", "retain"),
+					resource.TestCheckResourceAttr("mke_user.deactivated", "deletion_policy", "deactivate"),
+					func(*terraform.State) error {
+						// the user made more client bundles than fit on a page of keys
+						for i := 0; i <= mketest.DefaultPageLimit; i++ {
+							s.AddPublicKey("deactivated", fmt.Sprintf("bundle%d", i))
+						}
+						return nil
+					},
+				),
+			},
+			// Destroyed users are left in MKE, and deactivated users can't log in or use their keys
+			{
+				Config: testAccProviderConfig(s),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserFlags(s, "retained", true, false),
+					testAccCheckUserFlags(s, "deactivated", false, false),
+					func(*terraform.State) error {
+						if keys := s.PublicKeys("deactivated"); len(keys) != 0 {
+							return fmt.Errorf("expected the public keys of the deactivated user to be revoked, got %v", keys)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestUserResourceReplaceKeptUser(t *testing.T) {
+	s := testAccFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroyed(s, "ldapuser"),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccConfigureLDAP(t, s)
+					s.AddLDAPUser("ldapuser", "ldappassword")
+				},
+				Config: testAccProviderConfig(s) + testUserResourceAuthSource("managed", "deactivate"),
+			},
+			// The deactivated account would still exist, so the new user could not be created
+			{
+				Config:      testAccProviderConfig(s) + testUserResourceAuthSource("ldap", "deactivate"),
+				ExpectError: regexp.MustCompile(`deletion\s+policy\s+is\s+deactivate`),
+			},
+			// Once the policy is delete, the user is replaced
+			{
+				Config: testAccProviderConfig(s) + testUserResourceAuthSource("managed", "delete"),
+			},
+			{
+				Config: testAccProviderConfig(s) + testUserResourceAuthSource("ldap", "delete"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_user.test", "auth_source", "ldap"),
+					resource.TestCheckResourceAttr("mke_user.test", "is_imported", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testUserResourceAuthSource(authSource, deletionPolicy string) string {
+	password := ""
+	if authSource == "managed" {
+		password = `password = "testtest"`
+	}
+	return fmt.Sprintf(`
+	resource "mke_user" "test" {
+		name = "ldapuser"
+		auth_source = %q
+		deletion_policy = %q
+		%s
+	}`, authSource, deletionPolicy, password)
+}
+
+func TestUserResourceResetOTP(t *testing.T) {
+	s := testAccFakeServer(t)
+	enableOTP := func() {
+		s.ModifyAccount("test", func(acc *client.ResponseAccount) { acc.OtpEnabled = true })
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroyed(s, "test"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(s) + testUserResourceResetOTP(""),
+				Check:  resource.TestCheckResourceAttr("mke_user.test", "otp_enabled", "false"),
+			},
+			// The user set up two-factor authentication
+			{
+				PreConfig: enableOTP,
+				Config:    testAccProviderConfig(s) + testUserResourceResetOTP(""),
+				Check:     resource.TestCheckResourceAttr("mke_user.test", "otp_enabled", "true"),
+			},
+			// The user lost their device
+			{
+				Config: testAccProviderConfig(s) + testUserResourceResetOTP("TICKET-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_user.test", "otp_enabled", "false"),
+					resource.TestCheckResourceAttr("mke_user.test", "reset_otp", "TICKET-1"),
+					testAccCheckUserOTP(s, "test", false),
+				),
+			},
+			// The same value does not reset it again
+			{
+				PreConfig: enableOTP,
+				Config:    testAccProviderConfig(s) + testUserResourceResetOTP("TICKET-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_user.test", "otp_enabled", "true"),
+					testAccCheckUserOTP(s, "test", true),
+				),
+			},
+		},
+	})
+}
+
+func testUserResourceResetOTP(resetOTP string) string {
+	reset := ""
+	if resetOTP != "" {
+		reset = fmt.Sprintf("reset_otp = %q", resetOTP)
+	}
+	return fmt.Sprintf(`
+	resource "mke_user" "test" {
+		name = "test"
+		password = "testtest"
+		%s
+	}`, reset)
+}
+
+// testAccCheckUserOTP confirm the two-factor authentication state of an account in the fake MKE server.
+func testAccCheckUserOTP(s *mketest.Server, name string, otpEnabled bool) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if acc, _ := s.Account(name); acc.OtpEnabled != otpEnabled {
+			return fmt.Errorf("account %s has OTP enabled %t, expected %t", name, acc.OtpEnabled, otpEnabled)
+		}
+		return nil
+	}
+}
+
+func TestUserResourceLDAP(t *testing.T) {
+	s := testAccFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroyed(s, "ldapuser"),
+		Steps: []resource.TestStep{
+			// LDAP users don't get a generated password
+			{
+				Config: testAccProviderConfig(s) + `
+				resource "mke_user" "test" {
+					name = "ldapuser"
+					auth_source = "ldap"
+					password_policy {
+						length = 20
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`no\s+password\s+is\s+generated`),
+			},
+			// LDAP users can't have a password
+			{
+				Config: testAccProviderConfig(s) + `
+				resource "mke_user" "test" {
+					name = "ldapuser"
+					auth_source = "ldap"
+					password = "testtest"
+				}`,
+				ExpectError: regexp.MustCompile("LDAP users log in with their LDAP password"),
+			},
+			// LDAP users must be in LDAP
+			{
+				PreConfig:   func() { testAccConfigureLDAP(t, s) },
+				Config:      testAccProviderConfig(s) + testUserResourceLDAP(),
+				ExpectError: regexp.MustCompile(`no\s+LDAP\s+user\s+found`),
+			},
+			// Create and Read testing
+			{
+				PreConfig: func() { s.AddLDAPUser("ldapuser", "ldappassword") },
+				Config:    testAccProviderConfig(s) + testUserResourceLDAP(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_user.test", "auth_source", "ldap"),
+					resource.TestCheckResourceAttr("mke_user.test", "is_imported", "true"),
+					resource.TestCheckNoResourceAttr("mke_user.test", "password"),
+					testAccCheckUserInMKE(s, "ldapuser", "LDAP user", false),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "mke_user.test",
+				ImportState:       true,
+				ImportStateId:     "ldapuser",
+				ImportStateVerify: true,
+			},
+			// Delete is called implicitly
+		},
+	})
+}
+
+func TestUserResourcePasswordChange(t *testing.T) {
+	s := testAccFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroyed(s, "test"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(s) + testUserResourcePassword("test", "testtest1"),
+				Check:  testAccCheckUserPassword(s, "test", "testtest1"),
+			},
+			// An admin resets the password of another user
+			{
+				Config: testAccProviderConfig(s) + testUserResourcePassword("test", "testtest2"),
+				Check:  testAccCheckUserPassword(s, "test", "testtest2"),
+			},
+		},
+	})
+}
+
+func TestUserResourcePasswordNotAdmin(t *testing.T) {
+	s := testAccFakeServer(t)
+	s.CreateAccount(client.CreateAccount{Name: "plainuser", Password: "plainpassword", IsActive: true})
+	s.CreateAccount(client.CreateAccount{Name: "otheruser", Password: "otherpassword", IsActive: true})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:             testAccProviderConfigFor(s, "plainuser", "plainpassword") + testUserResourcePassword("otheruser", "otherpassword2"),
+				ResourceName:       "mke_user.test",
+				ImportState:        true,
+				ImportStateId:      "otheruser",
+				ImportStatePersist: true,
+			},
+			// Only admins can reset the passwords of other users
+			{
+				Config:      testAccProviderConfigFor(s, "plainuser", "plainpassword") + testUserResourcePassword("otheruser", "otherpassword2"),
+				ExpectError: regexp.MustCompile("not an MKE admin"),
+			},
+			// An admin can reset it, and clean up
+			{
+				Config: testAccProviderConfig(s) + testUserResourcePassword("otheruser", "otherpassword2"),
+				Check:  testAccCheckUserPassword(s, "otheruser", "otherpassword2"),
+			},
+		},
+	})
+}
+
+func TestUserResourcePasswordSelf(t *testing.T) {
+	s := testAccFakeServer(t)
+	s.CreateAccount(client.CreateAccount{Name: "plainuser", Password: "plainpassword", IsActive: true})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:             testAccProviderConfigFor(s, "plainuser", "plainpassword") + testUserResourcePassword("plainuser", "plainpassword2"),
+				ResourceName:       "mke_user.test",
+				ImportState:        true,
+				ImportStateId:      "plainuser",
+				ImportStatePersist: true,
+			},
+			// Users can change their own password, after which the provider password is stale
+			{
+				Config:      testAccProviderConfigFor(s, "plainuser", "plainpassword") + testUserResourcePassword("plainuser", "plainpassword2"),
+				ExpectError: regexp.MustCompile(`invalid\s+username\s+or\s+password`),
+			},
+			// An admin provider sees no change, and cleans up
+			{
+				Config: testAccProviderConfig(s) + testUserResourcePassword("plainuser", "plainpassword2"),
+				Check:  testAccCheckUserPassword(s, "plainuser", "plainpassword2"),
+			},
+		},
+	})
+}
+
+func TestUserResourceGeneratedPassword(t *testing.T) {
+	s := testAccFakeServer(t)
+	var first string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroyed(s, "test"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(s) + testUserResourceGenerated("one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("mke_user.test", "generated_password", func(value string) error {
+						if len(value) != 20 || strings.ContainsAny(value, client.PasswordCharsSpecial+"0Ol1I") {
+							return fmt.Errorf("generated password %q does not match the policy", value)
+						}
+						first = value
+						return nil
+					}),
+					testAccCheckUserGeneratedPassword(s, "test"),
+				),
+			},
+			// Changing the keepers rotates the password
+			{
+				Config: testAccProviderConfig(s) + testUserResourceGenerated("two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("mke_user.test", "generated_password", func(value string) error {
+						if value == first {
+							return fmt.Errorf("generated password was not rotated")
+						}
+						return nil
+					}),
+					testAccCheckUserGeneratedPassword(s, "test"),
+				),
+			},
+			// A configured password replaces the generated one
+			{
+				Config: testAccProviderConfig(s) + testUserResourcePassword("test", "testtest1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("mke_user.test", "generated_password"),
+					testAccCheckUserPassword(s, "test", "testtest1"),
+				),
+			},
+			// Removing it generates one again, with the default policy
+			{
+				Config: testAccProviderConfig(s) + `
+				resource "mke_user" "test" {
+					name = "test"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("mke_user.test", "generated_password", func(value string) error {
+						if len(value) != client.DefaultPasswordLength {
+							return fmt.Errorf("expected a generated password of length %d, got %q", client.DefaultPasswordLength, value)
+						}
+						return nil
+					}),
+					testAccCheckUserGeneratedPassword(s, "test"),
+				),
+			},
+		},
+	})
+}
+
+func TestUserResourceImportKeepsPassword(t *testing.T) {
+	s := testAccFakeServer(t)
+	s.CreateAccount(client.CreateAccount{Name: "test", Password: "importedpassword", IsActive: true})
+
+	config := testAccProviderConfig(s) + `
+	resource "mke_user" "test" {
+		name = "test"
+	}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:             config,
+				ResourceName:       "mke_user.test",
+				ImportState:        true,
+				ImportStateId:      "test",
+				ImportStatePersist: true,
+			},
+			// An imported user has no generated password, but keeps its existing one
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("mke_user.test", "generated_password"),
+					testAccCheckUserPassword(s, "test", "importedpassword"),
+				),
+			},
+			// Adding keepers starts tracking them, without rotating the password
+			{
+				Config: testAccProviderConfig(s) + testUserResourceGenerated("one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("mke_user.test", "generated_password"),
+					testAccCheckUserPassword(s, "test", "importedpassword"),
+				),
+			},
+			// Changing them afterwards rotates it
+			{
+				Config: testAccProviderConfig(s) + testUserResourceGenerated("two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("mke_user.test", "generated_password"),
+					testAccCheckUserGeneratedPassword(s, "test"),
+				),
+			},
+		},
+	})
+}
+
+func TestUserResourceInvalidPasswordPolicy(t *testing.T) {
+	s := testAccFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(s) + `
+				resource "mke_user" "test" {
+					name = "test"
+					password_policy {
+						length = 8
+						min_upper = 5
+						min_numeric = 5
+					}
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid\s+password\s+policy`),
+			},
+		},
+	})
+}
+
+func TestUserResourceWriteOnlyPassword(t *testing.T) {
+	s := testAccFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroyed(s, "test"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(s) + `
+				resource "mke_user" "test" {
+					name = "test"
+					password = "testtest1"
+					password_wo = "testtest1"
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Only\s+one\s+of\s+password\s+and\s+password_wo`),
+			},
+			{
+				Config: testAccProviderConfig(s) + testUserResourceWriteOnly("wopassword1", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("mke_user.test", "password_wo"),
+					resource.TestCheckNoResourceAttr("mke_user.test", "generated_password"),
+					resource.TestCheckResourceAttr("mke_user.test", "password_wo_version", "1"),
+					testAccCheckUserPassword(s, "test", "wopassword1"),
+				),
+			},
+			// The password is only sent when the version changes
+			{
+				Config: testAccProviderConfig(s) + testUserResourceWriteOnly("wopassword2", 1),
+				Check:  testAccCheckUserPassword(s, "test", "wopassword1"),
+			},
+			{
+				Config: testAccProviderConfig(s) + testUserResourceWriteOnly("wopassword2", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("mke_user.test", "password_wo"),
+					testAccCheckUserPassword(s, "test", "wopassword2"),
+				),
+			},
+		},
+	})
+}
+
+func testUserResourceWriteOnly(password string, version int) string {
+	return fmt.Sprintf(`
+	resource "mke_user" "test" {
+		name = "test"
+		password_wo = "%s"
+		password_wo_version = %d
+	}`, password, version)
+}
+
+func testUserResourceGenerated(keeper string) string {
+	return fmt.Sprintf(`
+	resource "mke_user" "test" {
+		name = "test"
+		keepers = {
+			rotation = "%s"
+		}
+		password_policy {
+			length  = 20
+			special = false
+			exclude = "0Ol1I"
+		}
+	}`, keeper)
+}
+
+// testAccCheckUserGeneratedPassword confirm that the account in the fake MKE server has the generated password.
+func testAccCheckUserGeneratedPassword(s *mketest.Server, name string) resource.TestCheckFunc {
+	return func(st *terraform.State) error {
+		rs, ok := st.RootModule().Resources["mke_user.test"]
+		if !ok {
+			return fmt.Errorf("mke_user.test not found in state")
+		}
+		return testAccCheckUserPassword(s, name, rs.Primary.Attributes["generated_password"])(st)
+	}
+}
+
+func testUserResourcePassword(name, password string) string {
+	return fmt.Sprintf(`
+	resource "mke_user" "test" {
+		name = "%s"
+		password = "%s"
+	}`, name, password)
+}
+
+// testAccCheckUserPassword confirm the password of an account in the fake MKE server.
+func testAccCheckUserPassword(s *mketest.Server, name, expected string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if password, _ := s.AccountPassword(name); password != expected {
+			return fmt.Errorf("account %s has password %q, expected %q", name, password, expected)
+		}
+		return nil
+	}
+}
+
+func testUserResourceLDAP() string {
+	return `
+	resource "mke_user" "test" {
+		name = "ldapuser"
+		full_name = "LDAP user"
+		auth_source = "ldap"
+	}`
+}
+
 func testUserResourceDefault() string {
 	return `
 	resource "mke_user" "test" {
@@ -65,3 +780,30 @@ func testUserResourceDefault() string {
 		full_name = "test"
 	}`
 }
+
+// testAccCheckUserInMKE confirm that the fake MKE server has the expected account.
+func testAccCheckUserInMKE(s *mketest.Server, name, fullName string, isAdmin bool) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		acc, ok := s.Account(name)
+		if !ok {
+			return fmt.Errorf("account %s does not exist in MKE", name)
+		}
+		if acc.FullName != fullName {
+			return fmt.Errorf("account %s has full name %q, expected %q", name, acc.FullName, fullName)
+		}
+		if acc.IsAdmin != isAdmin {
+			return fmt.Errorf("account %s has admin %t, expected %t", name, acc.IsAdmin, isAdmin)
+		}
+		return nil
+	}
+}
+
+// testAccCheckUserDestroyed confirm that the fake MKE server no longer has the account.
+func testAccCheckUserDestroyed(s *mketest.Server, name string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if _, ok := s.Account(name); ok {
+			return fmt.Errorf("account %s still exists in MKE", name)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &UsersDataSource{}
+
+type UsersDataSourceModel struct {
+	Filter     types.String          `tfsdk:"filter"`
+	NameRegex  types.String          `tfsdk:"name_regex"`
+	IsImported types.Bool            `tfsdk:"is_imported"`
+	OtpEnabled types.Bool            `tfsdk:"otp_enabled"`
+	Users      []UserDataSourceModel `tfsdk:"users"`
+}
+
+// matches does the account pass the filters which MKE can't apply itself.
+func (m UsersDataSourceModel) matches(acc client.ResponseAccount, nameRegex *regexp.Regexp) bool {
+	if acc.IsOrg {
+		return false
+	}
+	if nameRegex != nil && !nameRegex.MatchString(acc.Name) {
+		return false
+	}
+	if !m.IsImported.IsNull() && m.IsImported.ValueBool() != acc.IsImported {
+		return false
+	}
+	if !m.OtpEnabled.IsNull() && m.OtpEnabled.ValueBool() != acc.OtpEnabled {
+		return false
+	}
+	return true
+}
+
+type UsersDataSource struct {
+	providerModel MKEProviderModel
+}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{}
+}
+
+func (d *UsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	userAttributes := userDataSourceAttributes()
+	userAttributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The ID of the user",
+		Computed:            true,
+	}
+	userAttributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the user",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The MKE users which match all of the filters, sorted by name, e.g. to list the admins for an audit.",
+
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The MKE account filter, one of `%s`, `%s`, `%s`, `%s` or `%s`. Defaults to `%s`",
+					client.AccountFilterUsers, client.AccountFilterAdmins, client.AccountFilterNonAdmins,
+					client.AccountFilterActiveUsers, client.AccountFilterInactiveUsers, client.AccountFilterUsers),
+				Optional: true,
+				Validators: []validator.String{stringvalidator.OneOf(
+					string(client.AccountFilterUsers), string(client.AccountFilterAdmins), string(client.AccountFilterNonAdmins),
+					string(client.AccountFilterActiveUsers), string(client.AccountFilterInactiveUsers),
+				)},
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only users whose name matches this regular expression",
+				Optional:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"is_imported": schema.BoolAttribute{
+				MarkdownDescription: "Only users which were, or were not, imported from LDAP",
+				Optional:            true,
+			},
+			"otp_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Only users which have, or do not have, two-factor authentication enabled",
+				Optional:            true,
+			},
+			"users": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching users",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: userAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *UsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	lpm, ok := req.ProviderData.(MKEProviderModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *MKEProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	tflog.Debug(ctx, "Successfully interpeted provider model", map[string]interface{}{})
+	d.providerModel = lpm
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_users", "read")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data UsersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		if nameRegex, err = regexp.Compile(data.NameRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+			return
+		}
+	}
+
+	filter := client.AccountFilterUsers
+	if !data.Filter.IsNull() {
+		filter = client.AccountFilter(data.Filter.ValueString())
+	}
+
+	cl, err := d.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	accs, err := cl.ApiReadAccounts(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError("List users error", err.Error())
+		return
+	}
+
+	data.Users = []UserDataSourceModel{}
+	for _, acc := range accs {
+		if !data.matches(acc, nameRegex) {
+			continue
+		}
+		var user UserDataSourceModel
+		user.FromResponseAccount(acc)
+		data.Users = append(data.Users, user)
+	}
+	sort.Slice(data.Users, func(i, j int) bool { return data.Users[i].Name.ValueString() < data.Users[j].Name.ValueString() })
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
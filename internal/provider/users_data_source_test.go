@@ -0,0 +1,88 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+)
+
+func TestUsersDataSourceDefault(t *testing.T) {
+	s := testAccFakeServer(t)
+	// more users than fit on a page of the fake server listing
+	for i := 0; i < 110; i++ {
+		s.CreateAccount(client.CreateAccount{Name: fmt.Sprintf("user%03d", i), Password: "userpassword", IsActive: i%10 != 0})
+	}
+	s.CreateAccount(client.CreateAccount{Name: "ops-admin", Password: "opspassword", IsActive: true, IsAdmin: true})
+	s.CreateAccount(client.CreateAccount{Name: "ops-ldap", IsActive: true, SearchLDAP: true})
+	s.ModifyAccount("user007", func(acc *client.ResponseAccount) { acc.OtpEnabled = true })
+	s.CreateAccount(client.CreateAccount{Name: "someorg", IsOrg: true})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Every user, from all of the pages, without organizations
+			{
+				Config: testAccProviderConfig(s) + `data "mke_users" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.mke_users.test", "users.#", "113"),
+					resource.TestCheckResourceAttr("data.mke_users.test", "users.0.name", "admin"),
+					resource.TestCheckResourceAttr("data.mke_users.test", "users.112.name", "user109"),
+				),
+			},
+			// MKE filters
+			{
+				Config: testAccProviderConfig(s) + testUsersDataSource(`filter = "admins"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.mke_users.test", "users.#", "2"),
+					resource.TestCheckResourceAttr("data.mke_users.test", "users.0.name", "admin"),
+					resource.TestCheckResourceAttr("data.mke_users.test", "users.1.name", "ops-admin"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(s) + testUsersDataSource(`filter = "inactive-users"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.mke_users.test", "users.#", "11"),
+					resource.TestCheckResourceAttr("data.mke_users.test", "users.0.is_active", "false"),
+				),
+			},
+			// Provider filters, combined with each other
+			{
+				Config: testAccProviderConfig(s) + testUsersDataSource(`name_regex = "^ops-"`),
+				Check:  resource.TestCheckResourceAttr("data.mke_users.test", "users.#", "2"),
+			},
+			{
+				Config: testAccProviderConfig(s) + testUsersDataSource(`
+					name_regex = "^ops-"
+					is_imported = true`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.mke_users.test", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.mke_users.test", "users.0.name", "ops-ldap"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(s) + testUsersDataSource(`otp_enabled = true`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.mke_users.test", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.mke_users.test", "users.0.name", "user007"),
+					resource.TestCheckResourceAttr("data.mke_users.test", "users.0.otp_enabled", "true"),
+				),
+			},
+			{
+				Config:      testAccProviderConfig(s) + testUsersDataSource(`name_regex = "(unclosed"`),
+				ExpectError: regexp.MustCompile(`Invalid\s+name_regex`),
+			},
+		},
+	})
+}
+
+func testUsersDataSource(filters string) string {
+	return fmt.Sprintf(`
+	data "mke_users" "test" {
+		%s
+	}`, filters)
+}
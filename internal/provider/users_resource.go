@@ -0,0 +1,440 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// UsersID the ID of every mke_users resource, which is not used to look anything up.
+	UsersID = "users"
+	// UsersDefaultParallelism how many MKE calls are made at a time by default.
+	UsersDefaultParallelism = 10
+
+	UsersStatusCreated     = "created"
+	UsersStatusUpdated     = "updated"
+	UsersStatusUnchanged   = "unchanged"
+	UsersStatusFailed      = "failed"
+	UsersStatusDeactivated = "deactivated"
+)
+
+var _ resource.Resource = &UsersResource{}
+
+type UsersResourceModel struct {
+	Id          types.String               `tfsdk:"id"`
+	Users       map[string]UsersEntryModel `tfsdk:"users"`
+	Parallelism types.Int64                `tfsdk:"parallelism"`
+	Status      types.Map                  `tfsdk:"status"`
+}
+
+type UsersEntryModel struct {
+	FullName types.String `tfsdk:"full_name"`
+	IsAdmin  types.Bool   `tfsdk:"is_admin"`
+	IsActive types.Bool   `tfsdk:"is_active"`
+	LDAP     types.Bool   `tfsdk:"ldap"`
+}
+
+// usersEntryFromAccount the entry for an account in MKE.
+func usersEntryFromAccount(acc client.ResponseAccount) UsersEntryModel {
+	return UsersEntryModel{
+		FullName: types.StringValue(acc.FullName),
+		IsAdmin:  types.BoolValue(acc.IsAdmin),
+		IsActive: types.BoolValue(acc.IsActive),
+		LDAP:     types.BoolValue(acc.IsImported),
+	}
+}
+
+type UsersResource struct {
+	providerModel MKEProviderModel
+}
+
+func NewUsersResource() resource.Resource {
+	return &UsersResource{}
+}
+
+func (r *UsersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (r *UsersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Many users at once, e.g. from an HR export, reconciled with a single account listing and parallel MKE calls. " +
+			"Users which already exist are taken over. Users which are removed from the map, or when the resource is destroyed, are deactivated " +
+			"and their public keys revoked, but they are not deleted. Managed users get a random password which is not stored, " +
+			"so they should log in through SAML or have their password reset. Do not manage the same user with `mke_user` as well. " +
+			"Users which fail are errors, and the other users are still applied. If the first apply has failed users, terraform taints the " +
+			"resource, and replacing it deactivates every user before creating them again, so `terraform untaint` it to only retry the failed users.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier, always `users`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"users": schema.MapNestedAttribute{
+				MarkdownDescription: "The users, by name",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"full_name": schema.StringAttribute{
+							MarkdownDescription: "The full name of the user",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+						"is_admin": schema.BoolAttribute{
+							MarkdownDescription: "Is the user an admin",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"is_active": schema.BoolAttribute{
+							MarkdownDescription: "Is the user active",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+						"ldap": schema.BoolAttribute{
+							MarkdownDescription: "Is the user looked up in LDAP, instead of having a password managed by MKE. " +
+								"It can't be changed for existing users",
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+			"parallelism": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("How many MKE calls are made at a time. Defaults to `%d`", UsersDefaultParallelism),
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(UsersDefaultParallelism),
+				Validators:          []validator.Int64{int64validator.Between(1, 50)},
+			},
+			"status": schema.MapAttribute{
+				MarkdownDescription: "What the last apply did for each user: " +
+					"`created`, `updated`, `unchanged`, `deactivated` for users removed from `users`, " +
+					"or `failed`, in which case the next apply tries again",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *UsersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	lpm, ok := req.ProviderData.(MKEProviderModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *MKEProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	tflog.Debug(ctx, "Successfully interpeted provider model", map[string]interface{}{})
+	r.providerModel = lpm
+}
+
+// Create per user failures are errors, as they are for Update and Delete, and the state is still saved.
+// Terraform taints a resource whose create failed, so the next apply replaces it, which deactivates and then
+// reactivates the users. Untaint it to only retry the failed users, which Read finds missing or different.
+func (r *UsersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_users", "create")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data UsersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	statuses, failures, err := reconcileUsers(ctx, cl, data.Users, nil, int(data.Parallelism.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("List accounts error", err.Error())
+		return
+	}
+	for _, f := range failures {
+		resp.Diagnostics.AddAttributeError(path.Root("users").AtMapKey(f.name), "User failed", f.err.Error())
+	}
+
+	data.Id = types.StringValue(UsersID)
+	resp.Diagnostics.Append(data.setStatus(ctx, statuses)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UsersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_users", "read")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data UsersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	accounts, err := listUserAccounts(ctx, cl)
+	if err != nil {
+		resp.Diagnostics.AddError("List accounts error", err.Error())
+		return
+	}
+
+	for name := range data.Users {
+		acc, ok := accounts[name]
+		if !ok {
+			// removed outside of terraform, so the next apply creates it again
+			tflog.Debug(ctx, "User in state not found in MKE API", map[string]any{"name": name})
+			delete(data.Users, name)
+			continue
+		}
+		data.Users[name] = usersEntryFromAccount(acc)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UsersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_users", "update")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data, state UsersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	statuses, failures, err := reconcileUsers(ctx, cl, data.Users, state.Users, int(data.Parallelism.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("List accounts error", err.Error())
+		return
+	}
+	for _, f := range failures {
+		resp.Diagnostics.AddAttributeError(path.Root("users").AtMapKey(f.name), "User failed", f.err.Error())
+	}
+
+	resp.Diagnostics.Append(data.setStatus(ctx, statuses)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, "Updated 'users' resource", map[string]any{"success": !resp.Diagnostics.HasError()})
+}
+
+// Delete deactivate all of the users, instead of deleting them.
+func (r *UsersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := startOperationSpan(ctx, "mke_users", "delete")
+	defer func() { endOperationSpan(span, resp.Diagnostics) }()
+
+	var data UsersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.providerModel.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("MKE provider could not create a client", fmt.Sprintf("An error occurred creating the client: %s", err.Error()))
+		return
+	}
+
+	_, failures, err := reconcileUsers(ctx, cl, nil, data.Users, int(data.Parallelism.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("List accounts error", err.Error())
+		return
+	}
+	for _, f := range failures {
+		resp.Diagnostics.AddAttributeError(path.Root("users").AtMapKey(f.name), "User failed", f.err.Error())
+	}
+
+	tflog.Debug(ctx, "Deleted users resource", map[string]any{"success": !resp.Diagnostics.HasError()})
+}
+
+// setStatus set the status map from the statuses of the apply, which include the users removed from the model.
+func (m *UsersResourceModel) setStatus(ctx context.Context, statuses map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.Status, diags = types.MapValueFrom(ctx, types.StringType, statuses)
+	return diags
+}
+
+// usersFailure a user which could not be reconciled.
+type usersFailure struct {
+	name string
+	err  error
+}
+
+// listUserAccounts all of the user accounts in MKE, by name, from a single paginated listing.
+func listUserAccounts(ctx context.Context, cl client.Client) (map[string]client.ResponseAccount, error) {
+	accs, err := cl.ApiReadAccounts(ctx, client.AccountFilterUsers)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := map[string]client.ResponseAccount{}
+	for _, acc := range accs {
+		if !acc.IsOrg {
+			accounts[acc.Name] = acc
+		}
+	}
+	return accounts, nil
+}
+
+// reconcileUsers make MKE match the desired users, with at most parallelism calls at a time.
+// Users which are only in previous are deactivated. Returns the status of each user and the failures, sorted by name.
+func reconcileUsers(ctx context.Context, cl client.Client, desired, previous map[string]UsersEntryModel, parallelism int) (map[string]string, []usersFailure, error) {
+	// the listing also logs the client in, before the workers share it
+	accounts, err := listUserAccounts(ctx, cl)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var names []string
+	for name := range desired {
+		names = append(names, name)
+	}
+	for name := range previous {
+		if _, ok := desired[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	statuses := make([]string, len(names))
+	errs := make([]error, len(names))
+
+	sem := make(chan struct{}, max(parallelism, 1))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			acc, exists := accounts[name]
+			if entry, ok := desired[name]; ok {
+				statuses[i], errs[i] = reconcileUser(ctx, cl, name, entry, acc, exists)
+			} else {
+				statuses[i], errs[i] = UsersStatusDeactivated, nil
+				if exists && acc.IsActive {
+					if diags := deactivateUser(ctx, cl, acc.ID); diags.HasError() {
+						errs[i] = fmt.Errorf("deactivating user %s failed. %s", name, diags.Errors()[0].Detail())
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	status := map[string]string{}
+	var failures []usersFailure
+	for i, name := range names {
+		if errs[i] != nil {
+			statuses[i] = UsersStatusFailed
+			failures = append(failures, usersFailure{name: name, err: errs[i]})
+		}
+		status[name] = statuses[i]
+	}
+
+	tflog.Debug(ctx, "Reconciled users", map[string]any{"users": len(names), "failures": len(failures)})
+	return status, failures, nil
+}
+
+// reconcileUser create the user, or update only what differs from the account in MKE.
+func reconcileUser(ctx context.Context, cl client.Client, name string, entry UsersEntryModel, acc client.ResponseAccount, exists bool) (string, error) {
+	if !exists {
+		newAcc := client.CreateAccount{
+			Name:       name,
+			FullName:   entry.FullName.ValueString(),
+			IsAdmin:    entry.IsAdmin.ValueBool(),
+			IsActive:   entry.IsActive.ValueBool(),
+			SearchLDAP: entry.LDAP.ValueBool(),
+		}
+		if !newAcc.SearchLDAP {
+			password, err := client.GeneratePassword(client.DefaultPasswordPolicy())
+			if err != nil {
+				return UsersStatusFailed, err
+			}
+			newAcc.Password = password
+		}
+
+		if _, err := cl.ApiCreateAccount(ctx, newAcc); err != nil {
+			return UsersStatusFailed, err
+		}
+		return UsersStatusCreated, nil
+	}
+
+	if acc.IsImported != entry.LDAP.ValueBool() {
+		return UsersStatusFailed, fmt.Errorf("user %s already exists in MKE with a different auth source, ldap is %t", name, acc.IsImported)
+	}
+
+	update := client.UpdateAccount{}
+	changed := false
+	if acc.FullName != entry.FullName.ValueString() {
+		update.FullName = client.Ptr(entry.FullName.ValueString())
+		changed = true
+	}
+	if acc.IsAdmin != entry.IsAdmin.ValueBool() {
+		update.IsAdmin = client.Ptr(entry.IsAdmin.ValueBool())
+		changed = true
+	}
+	if acc.IsActive != entry.IsActive.ValueBool() {
+		update.IsActive = client.Ptr(entry.IsActive.ValueBool())
+		changed = true
+	}
+	if !changed {
+		return UsersStatusUnchanged, nil
+	}
+
+	if _, err := cl.ApiUpdateAccount(ctx, acc.ID, update); err != nil {
+		return UsersStatusFailed, err
+	}
+	return UsersStatusUpdated, nil
+}
@@ -0,0 +1,166 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/Mirantis/terraform-provider-mke/internal/client"
+	"github.com/Mirantis/terraform-provider-mke/internal/mketest"
+)
+
+// more users than fit on a page of the fake server listing
+const testAccUsersCount = 120
+
+func TestUsersResourceDefault(t *testing.T) {
+	s := testAccFakeServer(t)
+	s.CreateAccount(client.CreateAccount{Name: "existing", FullName: "Old Name", Password: "existingpassword", IsActive: true})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckUsersActive(s, 0, testAccUsersCount, false),
+			testAccCheckUserFlags(s, "existing", false, true),
+		),
+		Steps: []resource.TestStep{
+			// Create many users, and take over an existing one
+			{
+				Config: testAccProviderConfig(s) + testAccUsersResource(testAccUsersCount),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_users.test", "id", "users"),
+					resource.TestCheckResourceAttr("mke_users.test", "users.%", fmt.Sprint(testAccUsersCount+1)),
+					resource.TestCheckResourceAttr("mke_users.test", "status.user000", "created"),
+					resource.TestCheckResourceAttr("mke_users.test", "status.user119", "created"),
+					resource.TestCheckResourceAttr("mke_users.test", "status.existing", "updated"),
+					resource.TestCheckResourceAttr("mke_users.test", "users.existing.full_name", "Existing User"),
+					testAccCheckUsersActive(s, 0, testAccUsersCount, true),
+					testAccCheckUserFlags(s, "existing", true, true),
+				),
+			},
+			// Users removed from the map are deactivated
+			{
+				Config: testAccProviderConfig(s) + testAccUsersResource(100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_users.test", "users.%", "101"),
+					resource.TestCheckResourceAttr("mke_users.test", "status.user100", "deactivated"),
+					resource.TestCheckResourceAttr("mke_users.test", "status.%", fmt.Sprint(testAccUsersCount+1)),
+					resource.TestCheckResourceAttr("mke_users.test", "status.user000", "unchanged"),
+					testAccCheckUsersActive(s, 0, 100, true),
+					testAccCheckUsersActive(s, 100, testAccUsersCount, false),
+				),
+			},
+			// Drift is found by a single listing and corrected
+			{
+				PreConfig: func() {
+					s.ModifyAccount("user042", func(acc *client.ResponseAccount) { acc.IsActive = false })
+				},
+				Config: testAccProviderConfig(s) + testAccUsersResource(100),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("mke_users.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_users.test", "status.user042", "updated"),
+					resource.TestCheckResourceAttr("mke_users.test", "status.user041", "unchanged"),
+					testAccCheckUserFlags(s, "user042", true, false),
+				),
+			},
+			// Delete is called implicitly
+		},
+	})
+}
+
+func TestUsersResourcePartialFailure(t *testing.T) {
+	s := testAccFakeServer(t)
+	s.CreateAccount(client.CreateAccount{Name: "managed", Password: "managedpassword", IsActive: true})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A user which exists with another auth source fails, without stopping the others
+			{
+				Config:      testAccProviderConfig(s) + testAccUsersResourceLDAP(true),
+				ExpectError: regexp.MustCompile(`user\s+managed\s+already\s+exists\s+in\s+MKE\s+with\s+a\s+different\s+auth\s+source`),
+			},
+			// The failed create tainted the resource, so fixing the config replaces it, which takes both users over again
+			{
+				PreConfig: func() {
+					if err := testAccCheckUserFlags(s, "fine", true, false)(nil); err != nil {
+						t.Errorf("the user which did not fail was not created: %s", err)
+					}
+				},
+				Config: testAccProviderConfig(s) + testAccUsersResourceLDAP(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_users.test", "status.managed", "updated"),
+					resource.TestCheckResourceAttr("mke_users.test", "status.fine", "updated"),
+					testAccCheckUserFlags(s, "managed", true, false),
+					testAccCheckUserFlags(s, "fine", true, false),
+				),
+			},
+			// A failed update is an error as well, and the other users are still updated
+			{
+				Config:      testAccProviderConfig(s) + testAccUsersResourceLDAP(true),
+				ExpectError: regexp.MustCompile(`user\s+managed\s+already\s+exists\s+in\s+MKE\s+with\s+a\s+different\s+auth\s+source`),
+			},
+			// Fixing the config reconciles the failed user, alongside a change to another one
+			{
+				PreConfig: func() {
+					if acc, _ := s.Account("fine"); acc.FullName != "Fine true" {
+						t.Errorf("the user which did not fail was not updated, it has full name %q", acc.FullName)
+					}
+				},
+				Config: testAccProviderConfig(s) + testAccUsersResourceLDAP(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mke_users.test", "status.managed", "unchanged"),
+					resource.TestCheckResourceAttr("mke_users.test", "status.fine", "updated"),
+					resource.TestCheckResourceAttr("mke_users.test", "users.managed.ldap", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUsersResource(count int) string {
+	return fmt.Sprintf(`
+	resource "mke_users" "test" {
+		parallelism = 20
+		users = merge(
+			{ for i in range(%d) : format("user%%03d", i) => { full_name = "User ${i}" } },
+			{ existing = { full_name = "Existing User", is_admin = true } },
+		)
+	}`, count)
+}
+
+func testAccUsersResourceLDAP(ldap bool) string {
+	return fmt.Sprintf(`
+	resource "mke_users" "test" {
+		users = {
+			managed = { ldap = %t }
+			fine = { full_name = %q }
+		}
+	}`, ldap, fmt.Sprintf("Fine %t", ldap))
+}
+
+// testAccCheckUsersActive confirm the active flag of the userNNN accounts from start to end in the fake MKE server.
+func testAccCheckUsersActive(s *mketest.Server, start, end int, isActive bool) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		for i := start; i < end; i++ {
+			name := fmt.Sprintf("user%03d", i)
+			acc, ok := s.Account(name)
+			if !ok {
+				return fmt.Errorf("account %s does not exist in MKE", name)
+			}
+			if acc.IsActive != isActive {
+				return fmt.Errorf("account %s has active %t, expected %t", name, acc.IsActive, isActive)
+			}
+		}
+		return nil
+	}
+}
@@ -3,7 +3,9 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 
@@ -37,12 +39,26 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
+	ctx := context.Background()
+
 	opts := providerserver.ServeOpts{
 		Address: ProviderAddress,
 		Debug:   debug,
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	// tracing is only enabled if OTEL_EXPORTER_OTLP_ENDPOINT is set, and is best effort:
+	// if the exporter can't be configured, the provider runs without it.
+	// Errors go to stderr, which terraform captures in its logs, as stdout is used for the plugin handshake.
+	shutdownTracing, err := provider.ConfigureTracing(ctx, version)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "OpenTelemetry tracing is disabled, as the exporter could not be configured: %s\n", err)
+	}
+
+	err = providerserver.Serve(ctx, provider.New(version), opts)
+
+	if shutdownErr := shutdownTracing(ctx); shutdownErr != nil {
+		fmt.Fprintf(os.Stderr, "OpenTelemetry tracing did not shut down cleanly, and spans may be lost: %s\n", shutdownErr)
+	}
 
 	if err != nil {
 		log.Fatal(err.Error())